@@ -0,0 +1,133 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// The binary encoding of a Decimal starts with a header byte containing the format version in bits 7-6,
+// the sign in bit 5 and the number of fractional digits in bits 4-0.
+// It is followed by the integer as an unsigned varint and, if there are fractional digits, the fraction as an unsigned varint.
+// Varints use the encoding of encoding/binary and must be minimal, i.e. not end in a zero byte unless they are a single byte.
+const (
+	binaryVersion1    = 0b01_0_00000
+	binaryVersionMask = 0b11_0_00000
+	binaryNegative    = 0b00_1_00000
+	binaryDigits      = 0b00_0_11111
+	binaryMaxLength   = 1 + 2*binary.MaxVarintLen64
+)
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the versioned binary encoding of the decimal value to b and returns the extended buffer.
+func (d Decimal) AppendBinary(b []byte) ([]byte, error) {
+	header := byte(binaryVersion1) | d.Digits
+	if d.Negative {
+		header |= binaryNegative
+	}
+	b = append(b, header)
+	b = binary.AppendUvarint(b, d.Integer)
+	if d.Digits > 0 {
+		b = binary.AppendUvarint(b, d.Fraction)
+	}
+	return b, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The encoding takes between 2 and 21 bytes.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	return d.AppendBinary(make([]byte, 0, binaryMaxLength))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It rejects unknown versions, invalid values, non-minimal varints and trailing data.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("binary: no data")
+	}
+	if data[0]&binaryVersionMask != binaryVersion1 {
+		return fmt.Errorf("binary: unsupported version %d", data[0]>>6)
+	}
+	val := Decimal{Negative: data[0]&binaryNegative != 0, Digits: data[0] & binaryDigits}
+	if val.Digits > 19 {
+		return fmt.Errorf("binary: too many digits: %d", val.Digits)
+	}
+	pos := 1
+	var n int
+	if val.Integer, n = binary.Uvarint(data[pos:]); !minimalVarint(data[pos:], n) {
+		return fmt.Errorf("binary: invalid integer")
+	}
+	pos += n
+	if val.Digits > 0 {
+		if val.Fraction, n = binary.Uvarint(data[pos:]); !minimalVarint(data[pos:], n) {
+			return fmt.Errorf("binary: invalid fraction")
+		}
+		pos += n
+		if val.Fraction >= pow10[val.Digits] {
+			return fmt.Errorf("binary: fraction %d exceeds %d digits", val.Fraction, val.Digits)
+		}
+	}
+	if pos != len(data) {
+		return fmt.Errorf("binary: %d bytes of trailing data", len(data)-pos)
+	}
+	if val.Negative && val.Integer == 0 && val.Fraction == 0 {
+		return fmt.Errorf("binary: negative zero")
+	}
+	*d = val
+	return nil
+}
+
+// minimalVarint reports whether the first n bytes of b are a valid varint without redundant zero groups such as 0x80 0x00.
+// n is the length returned by binary.Uvarint or binary.Varint.
+func minimalVarint(b []byte, n int) bool {
+	return n == 1 || n > 1 && b[n-1] != 0
+}
+
+// GobEncode implements gob.GobEncoder using the encoding of `MarshalBinary`.
+func (d Decimal) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the encoding of `UnmarshalBinary`.
+func (d *Decimal) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the fixed-point value as a zigzag-encoded varint of its hundredths to b and returns the extended buffer.
+func (f Fixed) AppendBinary(b []byte) ([]byte, error) {
+	return binary.AppendVarint(b, int64(f)), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The encoding takes between 1 and 5 bytes.
+func (f Fixed) MarshalBinary() ([]byte, error) {
+	return f.AppendBinary(make([]byte, 0, 5))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It rejects values outside the fixed-point range, non-minimal varints and trailing data.
+func (f *Fixed) UnmarshalBinary(data []byte) error {
+	val, n := binary.Varint(data)
+	if !minimalVarint(data, n) {
+		return fmt.Errorf("binary: invalid fixed-point value")
+	}
+	if n != len(data) {
+		return fmt.Errorf("binary: %d bytes of trailing data", len(data)-n)
+	}
+	if val < math.MinInt32 || val > math.MaxInt32 {
+		return fmt.Errorf("binary: value exceeds fixed-point range")
+	}
+	*f = Fixed(val)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the encoding of `MarshalBinary`.
+func (f Fixed) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the encoding of `UnmarshalBinary`.
+func (f *Fixed) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
@@ -0,0 +1,183 @@
+package decimal_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/hex"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+// Compile-time interface checks.
+var (
+	_ encoding.BinaryAppender    = decimal.Decimal{}
+	_ encoding.BinaryMarshaler   = decimal.Decimal{}
+	_ encoding.BinaryUnmarshaler = (*decimal.Decimal)(nil)
+	_ gob.GobEncoder             = decimal.Decimal{}
+	_ gob.GobDecoder             = (*decimal.Decimal)(nil)
+	_ encoding.BinaryAppender    = decimal.Fixed(0)
+	_ encoding.BinaryMarshaler   = decimal.Fixed(0)
+	_ encoding.BinaryUnmarshaler = (*decimal.Fixed)(nil)
+	_ gob.GobEncoder             = decimal.Fixed(0)
+	_ gob.GobDecoder             = (*decimal.Fixed)(nil)
+)
+
+func TestDecimal_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		d    decimal.Decimal
+		hex  string
+	}{
+		{"zero", decimal.Decimal{}, "4000"},
+		{"zero_with_digits", decimal.Decimal{Digits: 2}, "420000"},
+		{"integer", decimal.Decimal{Integer: 300}, "40ac02"},
+		{"negative_integer", decimal.Decimal{Negative: true, Integer: 1}, "6001"},
+		{"fraction", decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}, "420c22"},
+		{"negative_fraction", decimal.Decimal{Negative: true, Fraction: 5, Digits: 1}, "610005"},
+		{"max", decimal.Decimal{Negative: true, Integer: 18446744073709551615, Fraction: 9999999999999999999, Digits: 19}, "73ffffffffffffffffff01ffff9fcfc8e0c8e38a01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			if hex.EncodeToString(got) != tt.hex {
+				t.Errorf("MarshalBinary() = %x, want %s", got, tt.hex)
+			}
+			var back decimal.Decimal
+			if err := back.UnmarshalBinary(got); err != nil {
+				t.Fatalf("UnmarshalBinary(%x) error = %v", got, err)
+			}
+			if back != tt.d {
+				t.Errorf("UnmarshalBinary(%x) = %#v, want %#v", got, back, tt.d)
+			}
+		})
+	}
+}
+
+func TestDecimal_UnmarshalBinary_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+	}{
+		{"empty", ""},
+		{"version_0", "0000"},
+		{"version_2", "8000"},
+		{"too_many_digits", "5400"},
+		{"missing_integer", "40"},
+		{"truncated_integer", "4080"},
+		{"non_minimal_integer", "408000"},
+		{"non_minimal_zero_integer", "4080808000"},
+		{"non_minimal_fraction", "42008100"},
+		{"missing_fraction", "4200"},
+		{"fraction_exceeds_digits", "420064"},
+		{"integer_overflow", "40ffffffffffffffffff02"},
+		{"trailing_data", "400000"},
+		{"negative_zero", "6000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d decimal.Decimal
+			if err := d.UnmarshalBinary(cborHex(tt.hex)); err == nil {
+				t.Errorf("UnmarshalBinary(%s) = %#v, want error", tt.hex, d)
+			}
+		})
+	}
+}
+
+func TestDecimal_AppendBinary(t *testing.T) {
+	d := decimal.Decimal{Integer: 123, Fraction: 456, Digits: 3}
+	want, _ := d.MarshalBinary()
+	got, _ := d.AppendBinary([]byte{0xff})
+	if !bytes.Equal(got, append([]byte{0xff}, want...)) {
+		t.Errorf("AppendBinary(ff) = %x, want ff%x", got, want)
+	}
+	buf := make([]byte, 0, 32)
+	if allocs := testing.AllocsPerRun(100, func() { buf, _ = d.AppendBinary(buf[:0]) }); allocs != 0 {
+		t.Errorf("AppendBinary() allocates %v times", allocs)
+	}
+}
+
+func TestDecimal_Gob(t *testing.T) {
+	type row struct {
+		Price decimal.Decimal
+		Qty   decimal.Fixed
+	}
+	in := []row{
+		{decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}, 150},
+		{decimal.Decimal{Negative: true, Integer: 18446744073709551615, Fraction: 1, Digits: 19}, -2147483648},
+		{decimal.Decimal{}, 0},
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var out []row
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if len(out) != len(in) {
+		t.Fatalf("gob Decode() = %v, want %v", out, in)
+	}
+	for i := range in {
+		if out[i] != in[i] {
+			t.Errorf("gob Decode()[%d] = %#v, want %#v", i, out[i], in[i])
+		}
+	}
+}
+
+func BenchmarkDecimal_AppendBinary(b *testing.B) {
+	d := decimal.Decimal{Integer: 123, Fraction: 123, Digits: 3}
+	buf := make([]byte, 0, 32)
+	for b.Loop() {
+		buf, _ = d.AppendBinary(buf[:0])
+	}
+}
+
+func BenchmarkDecimal_UnmarshalBinary(b *testing.B) {
+	data, _ := decimal.Decimal{Integer: 123, Fraction: 123, Digits: 3}.MarshalBinary()
+	var d decimal.Decimal
+	for b.Loop() {
+		_ = d.UnmarshalBinary(data)
+	}
+}
+
+func TestFixed_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		f   decimal.Fixed
+		hex string
+	}{
+		{0, "00"},
+		{1, "02"},
+		{-1, "01"},
+		{1250, "c413"},
+		{-1250, "c313"},
+		{2147483647, "feffffff0f"},
+		{-2147483648, "ffffffff0f"},
+	}
+	for _, tt := range tests {
+		got, err := tt.f.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() error = %v", err)
+		}
+		if hex.EncodeToString(got) != tt.hex {
+			t.Errorf("MarshalBinary(%d) = %x, want %s", int32(tt.f), got, tt.hex)
+		}
+		var back decimal.Fixed
+		if err := back.UnmarshalBinary(got); err != nil || back != tt.f {
+			t.Errorf("UnmarshalBinary(%x) = %d, %v, want %d", got, int32(back), err, int32(tt.f))
+		}
+	}
+}
+
+func TestFixed_UnmarshalBinary_Invalid(t *testing.T) {
+	for _, h := range []string{"", "80", "8080808080808080808002", "0000", "8080808010", "8000", "818000"} {
+		var f decimal.Fixed
+		if err := f.UnmarshalBinary(cborHex(h)); err == nil {
+			t.Errorf("UnmarshalBinary(%s) = %d, want error", h, int32(f))
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package decimal
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+// AppendJCS appends the value as a number in the JSON Canonicalization Scheme (RFC 8785) to b and returns the extended buffer.
+// JCS serializes numbers like ECMAScript's `Number.prototype.toString`, so the value is converted to the nearest double
+// and written with the shortest digits that identify it, e.g. "12.5", "1e-7" or "18446744073709552000".
+//
+// exact reports whether the value is exactly representable as an IEEE 754 double, i.e. whether it is a binary fraction
+// with at most 53 significant bits such as 12.5 or 2^53. Values such as 0.1 are not exact even though the output
+// reads back as the same decimal. Signers should reject or quote inexact values if the double must not differ from the decimal.
+func (d Decimal) AppendJCS(b []byte) (_ []byte, exact bool) {
+	d = d.Truncate()
+	if d.Integer == 0 && d.Fraction == 0 {
+		return append(b, '0'), true
+	}
+	var arr [48]byte
+	pos := d.text(&arr)
+	f, _ := strconv.ParseFloat(string(arr[pos:]), 64) // cannot fail, the text is a valid number well within range
+
+	// The 'e' format yields the shortest digits as "-d.ddde±xx", which are then laid out as ECMAScript does.
+	var buf [32]byte
+	e := strconv.AppendFloat(buf[:0], f, 'e', -1, 64)
+	if hi, lo, ok := d.binaryMantissa(); ok {
+		exact = significantBits(hi, lo) <= 53
+	}
+	return appendECMAScriptFloat(b, e), exact
+}
+
+// significantBits returns the number of bits between the highest and the lowest set bit of a 128-bit value.
+func significantBits(hi, lo uint64) int {
+	if hi == 0 {
+		return bits.Len64(lo) - bits.TrailingZeros64(lo)
+	}
+	if lo == 0 {
+		return bits.Len64(hi) - bits.TrailingZeros64(hi)
+	}
+	return 64 + bits.Len64(hi) - bits.TrailingZeros64(lo)
+}
+
+// appendECMAScriptFloat appends a float formatted by strconv with the 'e' format in the layout of ECMAScript's Number::toString.
+func appendECMAScriptFloat(b, e []byte) []byte {
+	if e[0] == '-' {
+		b = append(b, '-')
+		e = e[1:]
+	}
+	i := 0
+	for e[i] != 'e' {
+		i++
+	}
+	exp, _ := strconv.Atoi(string(e[i+1:]))
+	var digits [17]byte
+	k := copy(digits[:], e[:1])
+	if i > 1 {
+		k += copy(digits[k:], e[2:i])
+	}
+	return appendECMAScript(b, digits[:k], exp+1)
+}
+
+// appendECMAScript appends the digits of a number whose value is 0.digits × 10^n in the layout of
+// ECMAScript's Number::toString: plain notation for exponents from -6 to 20 and exponential notation otherwise.
+func appendECMAScript(b, digits []byte, n int) []byte {
+	k := len(digits)
+	switch {
+	case k <= n && n <= 21:
+		b = append(b, digits...)
+		for range n - k {
+			b = append(b, '0')
+		}
+	case 0 < n && n <= 21:
+		b = append(b, digits[:n]...)
+		b = append(b, '.')
+		b = append(b, digits[n:]...)
+	case -6 < n && n <= 0:
+		b = append(b, '0', '.')
+		for range -n {
+			b = append(b, '0')
+		}
+		b = append(b, digits...)
+	default:
+		b = append(b, digits[0])
+		if k > 1 {
+			b = append(b, '.')
+			b = append(b, digits[1:]...)
+		}
+		b = append(b, 'e')
+		if n-1 >= 0 {
+			b = append(b, '+')
+		}
+		b = strconv.AppendInt(b, int64(n-1), 10)
+	}
+	return b
+}
+
+// AppendXSDCanonical appends the value in the canonical form of XML Schema `xs:decimal` (XSD 1.1) to b
+// and returns the extended buffer. The canonical form has no leading or trailing zeros, no decimal point for integers,
+// at least one digit before the decimal point and no sign for zero, so equal values always produce identical strings,
+// e.g. "12.5", "-0.05" and "100".
+func (d Decimal) AppendXSDCanonical(b []byte) []byte {
+	var arr [48]byte
+	pos := d.Truncate().text(&arr)
+	return append(b, arr[pos:]...)
+}
+
+// AppendJCS appends the value as a number in the JSON Canonicalization Scheme (RFC 8785) to b and returns the extended buffer.
+// Fixed-point values have at most 11 significant digits and are always represented exactly.
+func (f Fixed) AppendJCS(b []byte) []byte {
+	b, _ = f.Decimal().AppendJCS(b)
+	return b
+}
+
+// AppendXSDCanonical appends the value in the canonical form of XML Schema `xs:decimal` as described by `Decimal.AppendXSDCanonical`.
+func (f Fixed) AppendXSDCanonical(b []byte) []byte {
+	return f.Decimal().AppendXSDCanonical(b)
+}
@@ -0,0 +1,112 @@
+package decimal_test
+
+import (
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestDecimal_AppendJCS(t *testing.T) {
+	tests := []struct {
+		name      string
+		d         decimal.Decimal
+		want      string
+		wantExact bool
+	}{
+		{"zero", decimal.Decimal{}, "0", true},
+		{"negative_zero", decimal.Decimal{Negative: true, Digits: 2}, "0", true},
+		{"integer", decimal.Decimal{Integer: 100}, "100", true},
+		{"trailing_zeros", decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}, "12.5", true},
+		{"negative", decimal.Decimal{Negative: true, Integer: 12, Fraction: 34, Digits: 2}, "-12.34", false},
+		// 0.1 reads back from "0.1" but the double is 0.1000000000000000055511151231257827…, so it is not exact.
+		{"tenth", decimal.Decimal{Fraction: 1, Digits: 1}, "0.1", false},
+		{"binary_fraction", decimal.Decimal{Fraction: 375, Digits: 3}, "0.375", true},
+		{"tiny_binary_fraction", decimal.Decimal{Fraction: 19073486328125, Digits: 19}, "0.0000019073486328125", true},
+		{"max_mantissa_fraction", decimal.Decimal{Integer: 4503599627370495, Fraction: 5, Digits: 1}, "4503599627370495.5", true},
+		{"beyond_mantissa_fraction", decimal.Decimal{Integer: 4503599627370496, Fraction: 25, Digits: 2}, "4503599627370496", false},
+		{"small_plain", decimal.Decimal{Fraction: 123, Digits: 8}, "0.00000123", false},
+		{"small_exponent", decimal.Decimal{Fraction: 123, Digits: 9}, "1.23e-7", false},
+		{"single_digit_exponent", decimal.Decimal{Negative: true, Fraction: 1, Digits: 7}, "-1e-7", false},
+		{"tiny", decimal.Decimal{Fraction: 1, Digits: 19}, "1e-19", false},
+		{"large", decimal.Decimal{Integer: 10000000000000000000}, "10000000000000000000", true},
+		{"max_safe", decimal.Decimal{Integer: 1 << 53}, "9007199254740992", true},
+		{"beyond_safe", decimal.Decimal{Integer: 1<<53 + 1}, "9007199254740992", false},
+		{"max_uint64", decimal.Decimal{Integer: 18446744073709551615}, "18446744073709552000", false},
+		{"long_fraction", decimal.Decimal{Fraction: 1234567890123456789, Digits: 19}, "0.12345678901234568", false},
+		{"fifteen_digits", decimal.Decimal{Integer: 123456789, Fraction: 123456, Digits: 6}, "123456789.123456", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, exact := tt.d.AppendJCS([]byte("x"))
+			if string(got) != "x"+tt.want || exact != tt.wantExact {
+				t.Errorf("Decimal.AppendJCS() = %q, %v, want %q, %v", got, exact, "x"+tt.want, tt.wantExact)
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_AppendJCS(b *testing.B) {
+	d := decimal.Decimal{Integer: 123, Fraction: 45, Digits: 2}
+	buf := make([]byte, 0, 32)
+	for b.Loop() {
+		buf, _ = d.AppendJCS(buf[:0])
+	}
+}
+
+func TestFixed_AppendJCS(t *testing.T) {
+	tests := []struct {
+		f    decimal.Fixed
+		want string
+	}{
+		{0, "0"},
+		{1250, "12.5"},
+		{-1, "-0.01"},
+		{2147483647, "21474836.47"},
+		{-2147483648, "-21474836.48"},
+	}
+	for _, tt := range tests {
+		if got := tt.f.AppendJCS(nil); string(got) != tt.want {
+			t.Errorf("Fixed(%d).AppendJCS() = %q, want %q", int32(tt.f), got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_AppendXSDCanonical(t *testing.T) {
+	tests := []struct {
+		name string
+		d    decimal.Decimal
+		want string
+	}{
+		{"zero", decimal.Decimal{}, "0"},
+		{"negative_zero", decimal.Decimal{Negative: true, Digits: 3}, "0"},
+		{"integer", decimal.Decimal{Integer: 100, Digits: 3}, "100"},
+		{"trailing_zeros", decimal.Decimal{Integer: 12, Fraction: 5000, Digits: 4}, "12.5"},
+		{"leading_zero", decimal.Decimal{Negative: true, Fraction: 5, Digits: 2}, "-0.05"},
+		{"long_fraction", decimal.Decimal{Fraction: 1234567890123456789, Digits: 19}, "0.1234567890123456789"},
+		{"max", decimal.Decimal{Integer: 18446744073709551615}, "18446744073709551615"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.AppendXSDCanonical([]byte("x")); string(got) != "x"+tt.want {
+				t.Errorf("Decimal.AppendXSDCanonical() = %q, want %q", got, "x"+tt.want)
+			}
+		})
+	}
+}
+
+func TestFixed_AppendXSDCanonical(t *testing.T) {
+	tests := []struct {
+		f    decimal.Fixed
+		want string
+	}{
+		{0, "0"},
+		{1200, "12"},
+		{1250, "12.5"},
+		{-5, "-0.05"},
+	}
+	for _, tt := range tests {
+		if got := tt.f.AppendXSDCanonical(nil); string(got) != tt.want {
+			t.Errorf("Fixed(%d).AppendXSDCanonical() = %q, want %q", int32(tt.f), got, tt.want)
+		}
+	}
+}
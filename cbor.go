@@ -15,15 +15,22 @@ const (
 	CBOR_INTPOS          = 0b000_00000
 	CBOR_INTNEG          = 0b001_00000
 	CBOR_BYTESTRING      = 0b010_00000
+	CBOR_TEXTSTRING      = 0b011_00000
+	CBOR_ARRAY           = 0b100_00000
 	CBOR_ARRAY_LEN2      = 0b100_00010
+	CBOR_MAP             = 0b101_00000
 	CBOR_TAG             = 0b110_00000
 	CBOR_TAG_BIGNUMPOS   = 0b110_00010
 	CBOR_TAG_BIGNUMNEG   = 0b110_00011
 	CBOR_TAG_DECIMALFRAC = 0b110_00100
+	CBOR_TAG_BIGFLOAT    = 0b110_00101
 	CBOR_TYPE7           = 0b111_00000
+	CBOR_NULL            = 0b111_10110
+	CBOR_UNDEFINED       = 0b111_10111
 	CBOR_FLOAT16         = 0b111_11001
 	CBOR_FLOAT32         = 0b111_11010
 	CBOR_FLOAT64         = 0b111_11011
+	CBOR_BREAK           = 0b111_11111
 )
 
 func cborPutInt(n uint64, buf []byte) int {
@@ -54,18 +61,20 @@ func cborPutInt(n uint64, buf []byte) int {
 // MarshalCBOR implements the cbor.Marshaler interface.
 // It encodes the decimal number according to RFC 8949 Section 3.4.4 for Decimal Fractions.
 // The format is a CBOR tag 4 containing a two-element array: [exponent, mantissa].
+// The number of fractional digits is kept, use `CBOROptions.Deterministic` for an encoding that only depends on the value.
 func (d Decimal) MarshalCBOR() ([]byte, error) {
-	out := make([]byte, 24)
+	return d.AppendCBOR(nil)
+}
+
+// AppendCBOR appends the CBOR encoding of the decimal value as produced by `MarshalCBOR` to b
+// and returns the extended buffer.
+func (d Decimal) AppendCBOR(b []byte) ([]byte, error) {
+	var arr [24]byte
+	out := arr[:]
 
 	if d.Digits == 0 {
-		if d.Negative {
-			out[0] = CBOR_INTNEG
-			n := cborPutInt(d.Integer-1, out)
-			return out[:n], nil
-		}
-		out[0] = CBOR_INTPOS
-		n := cborPutInt(d.Integer, out)
-		return out[:n], nil
+		n := cborPutMantissa(d.Negative, 0, d.Integer, out)
+		return append(b, out[:n]...), nil
 	}
 
 	out[0] = CBOR_TAG_DECIMALFRAC
@@ -77,249 +86,359 @@ func (d Decimal) MarshalCBOR() ([]byte, error) {
 	lo, carry := bits.Add64(lo, d.Fraction, 0)
 	hi += carry
 
+	n := cborPutMantissa(d.Negative, hi, lo, out[3:])
+	return append(b, out[:3+n]...), nil
+}
+
+// cborPutMantissa writes the 128-bit magnitude with the given sign as an integer, or as a bignum if it exceeds 64 bits.
+// buf must have room for 18 bytes. Negative zero is written as zero.
+func cborPutMantissa(neg bool, hi, lo uint64, buf []byte) int {
+	if neg && hi == 0 && lo == 0 {
+		neg = false
+	}
+	if neg {
+		var borrow uint64
+		lo, borrow = bits.Sub64(lo, 1, 0)
+		hi -= borrow
+	}
 	if hi == 0 {
-		if d.Negative {
-			out[3] = CBOR_INTNEG
-			n := cborPutInt(lo-1, out[3:])
-			return out[:3+n], nil
+		buf[0] = CBOR_INTPOS
+		if neg {
+			buf[0] = CBOR_INTNEG
 		}
-		out[3] = CBOR_INTPOS
-		n := cborPutInt(lo, out[3:])
-		return out[:3+n], nil
+		return cborPutInt(lo, buf)
 	}
-
-	if d.Negative {
-		out[3] = CBOR_TAG_BIGNUMNEG
-		lo, borrow := bits.Sub64(lo, 1, 0)
-		hi -= borrow
-		bytes := 16 - bits.LeadingZeros64(hi)/8
-		out[4] = CBOR_BYTESTRING | byte(bytes)
-		binary.BigEndian.PutUint64(out[8:], hi)
-		binary.BigEndian.PutUint64(out[16:], lo)
-		copy(out[5:], out[24-bytes:])
-		return out[:5+bytes], nil
-	}
-	out[3] = CBOR_TAG_BIGNUMPOS
+	buf[0] = CBOR_TAG_BIGNUMPOS
+	if neg {
+		buf[0] = CBOR_TAG_BIGNUMNEG
+	}
+	var tmp [16]byte
+	binary.BigEndian.PutUint64(tmp[:8], hi)
+	binary.BigEndian.PutUint64(tmp[8:], lo)
 	bytes := 16 - bits.LeadingZeros64(hi)/8
-	out[4] = CBOR_BYTESTRING | byte(bytes)
-	binary.BigEndian.PutUint64(out[8:], hi)
-	binary.BigEndian.PutUint64(out[16:], lo)
-	copy(out[5:], out[24-bytes:])
-	return out[:5+bytes], nil
+	buf[1] = CBOR_BYTESTRING | byte(bytes)
+	copy(buf[2:], tmp[16-bytes:])
+	return 2 + bytes
 }
 
-func cborParseInt(buf []byte) (uint64, int, bool, error) {
+// cborHead parses the initial byte and argument of a data item.
+// It returns the major type, the additional information, the argument and the number of bytes consumed.
+// Non-preferred argument encodings are accepted. For indefinite lengths (additional information 31) the argument is zero.
+func cborHead(buf []byte) (byte, byte, uint64, int, error) {
 	if len(buf) < 1 {
-		return 0, 0, false, fmt.Errorf("cbor: not enough data for integer at %d bytes", len(buf))
-	}
-	neg := false
-	bytes := 0
-	switch buf[0] & CBOR_MAJOR {
-	case CBOR_INTPOS:
-		neg = false
-	case CBOR_INTNEG:
-		neg = true
-	default:
-		return 0, 0, false, fmt.Errorf("cbor: unexpected major type %d when parsing integer", buf[0]>>5)
+		return 0, 0, 0, 0, fmt.Errorf("cbor: unexpected end of data")
 	}
-	var val uint64
-	additional := buf[0] & CBOR_ADDITIONAL
+	major, additional := buf[0]&CBOR_MAJOR, buf[0]&CBOR_ADDITIONAL
 	switch additional {
 	case 24:
 		if len(buf) < 2 {
-			return 0, 0, false, fmt.Errorf("cbor: not enough data for integer with additional information 24 at %d bytes", len(buf))
+			return 0, 0, 0, 0, fmt.Errorf("cbor: not enough data for argument with additional information 24 at %d bytes", len(buf))
 		}
-		val = uint64(buf[1])
-		bytes = 2
+		return major, additional, uint64(buf[1]), 2, nil
 	case 25:
 		if len(buf) < 3 {
-			return 0, 0, false, fmt.Errorf("cbor: not enough data for integer with additional information 25 at %d bytes", len(buf))
+			return 0, 0, 0, 0, fmt.Errorf("cbor: not enough data for argument with additional information 25 at %d bytes", len(buf))
 		}
-		val = uint64(binary.BigEndian.Uint16(buf[1:]))
-		bytes = 3
+		return major, additional, uint64(binary.BigEndian.Uint16(buf[1:])), 3, nil
 	case 26:
 		if len(buf) < 5 {
-			return 0, 0, false, fmt.Errorf("cbor: not enough data for integer with additional information 26 at %d bytes", len(buf))
+			return 0, 0, 0, 0, fmt.Errorf("cbor: not enough data for argument with additional information 26 at %d bytes", len(buf))
 		}
-		val = uint64(binary.BigEndian.Uint32(buf[1:]))
-		bytes = 5
+		return major, additional, uint64(binary.BigEndian.Uint32(buf[1:])), 5, nil
 	case 27:
 		if len(buf) < 9 {
-			return 0, 0, false, fmt.Errorf("cbor: not enough data for integer with additional information 27 at %d bytes", len(buf))
+			return 0, 0, 0, 0, fmt.Errorf("cbor: not enough data for argument with additional information 27 at %d bytes", len(buf))
 		}
-		val = binary.BigEndian.Uint64(buf[1:])
-		bytes = 9
+		return major, additional, binary.BigEndian.Uint64(buf[1:]), 9, nil
+	case 28, 29, 30:
+		return 0, 0, 0, 0, fmt.Errorf("cbor: reserved additional information %d", additional)
+	case 31:
+		if major == CBOR_INTPOS || major == CBOR_INTNEG || major == CBOR_TAG {
+			return 0, 0, 0, 0, fmt.Errorf("cbor: indefinite length not allowed for major type %d", major>>5)
+		}
+		return major, additional, 0, 1, nil
 	default:
-		if additional >= 24 {
-			return 0, 0, false, fmt.Errorf("cbor: unexpected additional information %d when parsing integer", additional)
+		return major, additional, uint64(additional), 1, nil
+	}
+}
+
+// cborBignum parses the byte string content of a bignum tag as a 128-bit unsigned integer.
+// Definite and indefinite length byte strings are accepted, leading zero bytes are ignored.
+func cborBignum(buf []byte) (hi, lo uint64, n int, err error) {
+	major, additional, length, n, err := cborHead(buf)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if major != CBOR_BYTESTRING {
+		return 0, 0, 0, fmt.Errorf("cbor: bignum tag is not followed by bytestring")
+	}
+	add := func(chunk []byte) error {
+		for _, c := range chunk {
+			if hi>>56 != 0 {
+				return fmt.Errorf("cbor: bignum is too large for decimal value")
+			}
+			hi = hi<<8 | lo>>56
+			lo = lo<<8 | uint64(c)
 		}
-		val = uint64(additional)
-		bytes = 1
+		return nil
 	}
-	if neg {
-		if val == math.MaxUint64 {
-			return 0, 0, false, fmt.Errorf("cbor: negative integer overflows uint64")
+	if additional != 31 {
+		if uint64(len(buf)-n) < length {
+			return 0, 0, 0, fmt.Errorf("cbor: not enough data for bignum of %d bytes", length)
 		}
-		val++
+		if err := add(buf[n : n+int(length)]); err != nil {
+			return 0, 0, 0, err
+		}
+		return hi, lo, n + int(length), nil
+	}
+	for {
+		if n >= len(buf) {
+			return 0, 0, 0, fmt.Errorf("cbor: unexpected end of data in indefinite length bignum")
+		}
+		if buf[n] == CBOR_BREAK {
+			return hi, lo, n + 1, nil
+		}
+		major, additional, length, m, err := cborHead(buf[n:])
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if major != CBOR_BYTESTRING || additional == 31 {
+			return 0, 0, 0, fmt.Errorf("cbor: indefinite length bignum contains chunk that is not a definite length bytestring")
+		}
+		n += m
+		if uint64(len(buf)-n) < length {
+			return 0, 0, 0, fmt.Errorf("cbor: not enough data for bignum chunk of %d bytes", length)
+		}
+		if err := add(buf[n : n+int(length)]); err != nil {
+			return 0, 0, 0, err
+		}
+		n += int(length)
 	}
-	return val, bytes, neg, nil
 }
 
-// UnmarshalCBOR implements the cbor.Unmarshaler interface.
-// It supports decoding RFC 8949 Decimal Fractions, standard floats, and integers.
-func (d *Decimal) UnmarshalCBOR(data []byte) error {
-	if len(data) < 1 {
-		return fmt.Errorf("cbor: input too short at %d bytes", len(data))
+// cborMantissa parses an integer or bignum as a 128-bit magnitude and sign.
+func cborMantissa(buf []byte) (neg bool, hi, lo uint64, n int, err error) {
+	major, _, arg, n, err := cborHead(buf)
+	if err != nil {
+		return false, 0, 0, 0, err
 	}
-	switch data[0] & CBOR_MAJOR {
-	case CBOR_INTPOS, CBOR_INTNEG:
-		d.Digits = 0
-		d.Fraction = 0
-		val, bytes, neg, err := cborParseInt(data)
+	switch {
+	case major == CBOR_INTPOS:
+		return false, 0, arg, n, nil
+	case major == CBOR_INTNEG:
+		lo, carry := bits.Add64(arg, 1, 0)
+		return true, carry, lo, n, nil
+	case major == CBOR_TAG && (arg == 2 || arg == 3):
+		hi, lo, m, err := cborBignum(buf[n:])
 		if err != nil {
-			return err
+			return false, 0, 0, 0, err
 		}
-		if bytes != len(data) {
-			return fmt.Errorf("cbor: integer did not consume entire data")
+		if arg == 3 {
+			var carry uint64
+			lo, carry = bits.Add64(lo, 1, 0)
+			if hi, carry = bits.Add64(hi, carry, 0); carry != 0 {
+				return false, 0, 0, 0, fmt.Errorf("cbor: bignum is too large for decimal value")
+			}
 		}
-		d.Integer = val
-		d.Negative = neg
-		return nil
+		return arg == 3, hi, lo, n + m, nil
+	case major == CBOR_TAG:
+		return false, 0, 0, 0, fmt.Errorf("cbor: unexpected tag %d, cannot parse as integer", arg)
+	default:
+		return false, 0, 0, 0, fmt.Errorf("cbor: unexpected major type %d, cannot parse as integer", major>>5)
+	}
+}
+
+// cborScaled converts the value mantissa × 10^exponent to a decimal value, where the exponent is given by its sign and magnitude.
+// Trailing zeros of the mantissa are removed if the exponent asks for more than 19 fractional digits.
+func cborScaled(neg bool, hi, lo uint64, expNeg bool, exp uint64) (Decimal, error) {
+	if hi == 0 && lo == 0 {
+		if expNeg {
+			return Decimal{Digits: uint8(min(exp, 19))}, nil
+		}
+		return Zero(), nil
+	}
+	if !expNeg {
+		if hi != 0 || exp >= uint64(len(pow10)) {
+			return Zero(), fmt.Errorf("cbor: decimal fraction overflows uint64")
+		}
+		h, l := bits.Mul64(lo, pow10[exp])
+		if h != 0 {
+			return Zero(), fmt.Errorf("cbor: decimal fraction overflows uint64")
+		}
+		return Decimal{Negative: neg, Integer: l}, nil
+	}
+	for ; exp > 19; exp-- {
+		q, r := hi/10, hi%10
+		l, r := bits.Div64(r, lo, 10)
+		if r != 0 {
+			return Zero(), fmt.Errorf("cbor: more digits in decimal fraction than can be represented")
+		}
+		hi, lo = q, l
+	}
+	if hi >= pow10[exp] {
+		return Zero(), fmt.Errorf("cbor: decimal fraction overflows uint64")
+	}
+	integer, fraction := bits.Div64(hi, lo, pow10[exp])
+	return Decimal{Negative: neg, Integer: integer, Fraction: fraction, Digits: uint8(exp)}, nil
+}
+
+// cborFraction parses the [exponent, mantissa] array content of a decimal fraction (tag 4) or, if binary is set, a bigfloat (tag 5).
+func cborFraction(buf []byte, binary bool) (Decimal, int, error) {
+	kind := "decimal fraction"
+	if binary {
+		kind = "bigfloat"
+	}
+	major, additional, length, n, err := cborHead(buf)
+	if err != nil {
+		return Zero(), 0, err
+	}
+	if major != CBOR_ARRAY || (additional != 31 && length != 2) {
+		return Zero(), 0, fmt.Errorf("cbor: %s tag not followed by two-element array", kind)
+	}
+	major, _, exp, m, err := cborHead(buf[n:])
+	if err != nil {
+		return Zero(), 0, err
+	}
+	if major != CBOR_INTPOS && major != CBOR_INTNEG {
+		return Zero(), 0, fmt.Errorf("cbor: unexpected major type %d as exponent in %s", major>>5, kind)
+	}
+	expNeg := major == CBOR_INTNEG
+	if expNeg && exp != math.MaxUint64 {
+		exp++ // an exponent of -2^64 saturates, no non-zero mantissa can compensate for it
+	}
+	n += m
+	neg, hi, lo, m, err := cborMantissa(buf[n:])
+	if err != nil {
+		return Zero(), 0, err
+	}
+	n += m
+	if additional == 31 {
+		if n >= len(buf) || buf[n] != CBOR_BREAK {
+			return Zero(), 0, fmt.Errorf("cbor: %s tag not followed by two-element array", kind)
+		}
+		n++
+	}
+	var d Decimal
+	if binary {
+		d, err = cborBinaryScaled(neg, hi, lo, expNeg, exp)
+	} else {
+		d, err = cborScaled(neg, hi, lo, expNeg, exp)
+	}
+	return d, n, err
+}
+
+// cborBinaryScaled converts the value mantissa × 2^exponent to a decimal value, where the exponent is given by its sign and magnitude.
+// A negative exponent k is exact with k fractional digits, since 2^-k = 5^k / 10^k. Factors of two in the mantissa reduce k first.
+func cborBinaryScaled(neg bool, hi, lo uint64, expNeg bool, exp uint64) (Decimal, error) {
+	if hi == 0 && lo == 0 {
+		return Zero(), nil
+	}
+	if !expNeg {
+		if hi != 0 || exp >= 64 || lo>>(64-exp) != 0 {
+			return Zero(), fmt.Errorf("cbor: bigfloat overflows uint64")
+		}
+		return Decimal{Negative: neg, Integer: lo << exp}, nil
+	}
+	for ; exp > 0 && lo&1 == 0; exp-- {
+		lo = lo>>1 | hi<<63
+		hi >>= 1
+	}
+	if exp > 19 {
+		return Zero(), fmt.Errorf("cbor: more digits in bigfloat than can be represented")
+	}
+	pow5 := pow10[exp] >> exp
+	h1, l := bits.Mul64(lo, pow5)
+	h2, m := bits.Mul64(hi, pow5)
+	h, carry := bits.Add64(h1, m, 0)
+	if h2 != 0 || carry != 0 {
+		return Zero(), fmt.Errorf("cbor: bigfloat overflows uint64")
+	}
+	return cborScaled(neg, h, l, true, exp)
+}
+
+// cborFloat converts a floating point value to a decimal value as `New` does, rejecting values that cannot be represented.
+func cborFloat(f float64) (Decimal, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) || math.Abs(f) >= 1<<64 {
+		return Zero(), fmt.Errorf("cbor: float %g cannot be represented as decimal", f)
+	}
+	return New(f), nil
+}
+
+// cborDecode decodes a single data item from the start of buf as a decimal value and returns the number of bytes consumed.
+func cborDecode(buf []byte) (Decimal, int, error) {
+	major, additional, arg, n, err := cborHead(buf)
+	if err != nil {
+		return Zero(), 0, err
+	}
+	switch major {
 	case CBOR_TAG:
-		switch data[0] {
-		case CBOR_TAG_DECIMALFRAC:
-			if len(data) < 4 {
-				return fmt.Errorf("cbor: not enough data for decimal fraction")
-			}
-			if data[1] != CBOR_ARRAY_LEN2 {
-				return fmt.Errorf("cbor: decimal fraction tag not followed by two-element array")
-			}
-			expVal, expBytes, expNeg, err := cborParseInt(data[2:])
-			if err != nil {
-				return err
-			}
-			if expVal > 19 {
-				return fmt.Errorf("cbor: decimal fraction power exceeds uint64")
-			}
-			if len(data) < 2+expBytes+1 {
-				return fmt.Errorf("cbor: not enough data for mantissa in decimal fraction")
-			}
-			switch data[2+expBytes] & CBOR_MAJOR {
-			case CBOR_INTPOS, CBOR_INTNEG:
-				mantVal, mantBytes, mantNeg, err := cborParseInt(data[2+expBytes:])
-				if err != nil {
-					return err
-				}
-				if 2+expBytes+mantBytes < len(data) {
-					return fmt.Errorf("cbor: decimal fraction did not consume entire data")
-				}
-				d.Negative = mantNeg
-				if !expNeg {
-					hi, lo := bits.Mul64(mantVal, pow10[expVal])
-					if hi != 0 {
-						return fmt.Errorf("cbor: decimal fraction value overflows uint64")
-					}
-					d.Digits = 0
-					d.Fraction = 0
-					d.Integer = lo
-					return nil
-				}
-				d.Integer, d.Fraction = bits.Div64(0, mantVal, pow10[expVal])
-				d.Digits = uint8(expVal)
-				return nil
-			case CBOR_TAG:
-				mantNeg := false
-				switch data[2+expBytes] {
-				case CBOR_TAG_BIGNUMNEG:
-					mantNeg = true
-					fallthrough
-				case CBOR_TAG_BIGNUMPOS:
-					if len(data) < 2+expBytes+2 {
-						return fmt.Errorf("cbor: not enough data for mantissa of decimal fraction")
-					}
-					if data[2+expBytes+1]&CBOR_MAJOR != CBOR_BYTESTRING {
-						return fmt.Errorf("cbor: bignum tag is not followed by bytestring")
-					}
-					mantBytes := int(data[2+expBytes+1] & CBOR_ADDITIONAL)
-					if mantBytes > 16 {
-						return fmt.Errorf("cbor: bignum for mantissa is too large for decimal value")
-					}
-					if len(data) != 2+expBytes+2+mantBytes {
-						return fmt.Errorf("cbor: incorrect number of bytes for mantissa in decimal fraction, expected %d, got %d", mantBytes, len(data)-2-expBytes-2)
-					}
-					buf := [16]byte{}
-					copy(buf[16-mantBytes:], data[2+expBytes+2:])
-					hi := binary.BigEndian.Uint64(buf[:8])
-					lo := binary.BigEndian.Uint64(buf[8:])
-					if mantNeg {
-						carry := uint64(0)
-						lo, carry = bits.Add64(lo, 1, 0)
-						hi += carry
-					}
-					if !expNeg {
-						if hi != 0 {
-							return fmt.Errorf("cbor: decimal fraction overflows uint64")
-						}
-						overflow, val := bits.Mul64(lo, pow10[expVal])
-						if overflow != 0 {
-							return fmt.Errorf("cbor: decimal fraction overflows uint64")
-						}
-						d.Digits = 0
-						d.Fraction = 0
-						d.Integer = val
-						d.Negative = mantNeg
-						return nil
-					}
-					if pow10[expVal] <= hi {
-						return fmt.Errorf("cbor: decimal fraction overflows uint64")
-					}
-					d.Integer, d.Fraction = bits.Div64(hi, lo, pow10[expVal])
-					d.Digits = uint8(expVal)
-					d.Negative = mantNeg
-					return nil
-				default:
-					return fmt.Errorf("cbor: unexpected tag %d as mantissa in decimal fraction", data[2+expBytes]&CBOR_ADDITIONAL)
-				}
-			default:
-				return fmt.Errorf("cbor: unexpected major type %d as mantissa in decimal fraction", data[2+expBytes]>>5)
-			}
-		case CBOR_TAG_BIGNUMPOS, CBOR_TAG_BIGNUMNEG:
-			return fmt.Errorf("cbor: cannot parse bignum as decimal as it would overflow uint64")
+		switch arg {
+		case CBOR_TAG_DECIMALFRAC & CBOR_ADDITIONAL, CBOR_TAG_BIGFLOAT & CBOR_ADDITIONAL:
+			d, m, err := cborFraction(buf[n:], arg == CBOR_TAG_BIGFLOAT&CBOR_ADDITIONAL)
+			return d, n + m, err
+		case CBOR_TAG_BIGNUMPOS & CBOR_ADDITIONAL, CBOR_TAG_BIGNUMNEG & CBOR_ADDITIONAL:
 		default:
-			return fmt.Errorf("cbor: unexpected tag %d, cannot parse as decimal", data[0]&CBOR_ADDITIONAL)
+			return Zero(), 0, fmt.Errorf("cbor: unexpected tag %d, cannot parse as decimal", arg)
+		}
+		fallthrough
+	case CBOR_INTPOS, CBOR_INTNEG:
+		neg, hi, lo, n, err := cborMantissa(buf)
+		if err != nil {
+			return Zero(), 0, err
+		}
+		if hi != 0 {
+			return Zero(), 0, fmt.Errorf("cbor: integer overflows uint64")
 		}
+		return Decimal{Negative: neg, Integer: lo}, n, nil
 	case CBOR_TYPE7:
-		switch data[0] {
-		case CBOR_FLOAT16:
-			if len(data) != 3 {
-				return fmt.Errorf("cbor: unexpected length %d for float16", len(data))
-			}
-			*d = New(float16.Frombits(binary.BigEndian.Uint16(data[1:])).Float32())
-			return nil
-		case CBOR_FLOAT32:
-			if len(data) != 5 {
-				return fmt.Errorf("cbor: unexpected length %d for float32", len(data))
-			}
-			*d = New(math.Float32frombits(binary.BigEndian.Uint32(data[1:])))
-			return nil
-		case CBOR_FLOAT64:
-			if len(data) != 9 {
-				return fmt.Errorf("cbor: unexpected length %d for float64", len(data))
-			}
-			*d = New(math.Float64frombits(binary.BigEndian.Uint64(data[1:])))
-			return nil
+		var f float64
+		switch additional {
+		case 25:
+			f = float64(float16.Frombits(uint16(arg)).Float32())
+		case 26:
+			f = float64(math.Float32frombits(uint32(arg)))
+		case 27:
+			f = math.Float64frombits(arg)
+		case 31:
+			return Zero(), 0, fmt.Errorf("cbor: unexpected break")
 		default:
-			return fmt.Errorf("cbor: unexpected simple value %d, cannot parse as decimal", data[0]&CBOR_ADDITIONAL)
+			return Zero(), 0, fmt.Errorf("cbor: unexpected simple value %d, cannot parse as decimal", arg)
 		}
+		d, err := cborFloat(f)
+		return d, n, err
 	default:
-		return fmt.Errorf("cbor: unexpected major type %d, cannot parse as decimal", data[0]>>5)
+		return Zero(), 0, fmt.Errorf("cbor: unexpected major type %d, cannot parse as decimal", major>>5)
 	}
 }
 
+// UnmarshalCBOR implements the cbor.Unmarshaler interface.
+// It accepts every well-formed RFC 8949 encoding of a representable value: integers, bignums (tags 2 and 3),
+// decimal fractions (tag 4) and bigfloats (tag 5) with integer or bignum mantissas, and float16/32/64 values.
+// Bigfloats are decoded exactly and rejected if they need more than 19 fractional digits.
+// Non-preferred argument encodings, indefinite length arrays and byte strings, and bignums with leading zeros are accepted.
+// The data must contain exactly one item, trailing data is an error. The receiver is left unchanged if an error is returned.
+func (d *Decimal) UnmarshalCBOR(data []byte) error {
+	v, n, err := cborDecode(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("cbor: %d bytes of trailing data after value", len(data)-n)
+	}
+	*d = v
+	return nil
+}
+
 // MarshalCBOR implements the cbor.Marshaler interface.
 // It encodes the fixed-point value as a Decimal Fraction, identical to Decimal.MarshalCBOR.
 func (f Fixed) MarshalCBOR() ([]byte, error) {
-	return f.Decimal().MarshalCBOR()
+	return f.Decimal().AppendCBOR(nil)
+}
+
+// AppendCBOR appends the CBOR encoding of the fixed-point value as produced by `MarshalCBOR` to b
+// and returns the extended buffer.
+func (f Fixed) AppendCBOR(b []byte) ([]byte, error) {
+	return f.Decimal().AppendCBOR(b)
 }
 
 // UnmarshalCBOR implements the cbor.Unmarshaler interface.
@@ -331,33 +450,10 @@ func (f *Fixed) UnmarshalCBOR(data []byte) error {
 	if err != nil {
 		return err
 	}
-	d = d.Truncate()
-	var frac uint64
-	switch d.Digits {
-	case 0:
-	case 1:
-		frac = d.Fraction * 10
-	case 2:
-		frac = d.Fraction
-	default:
-		return fmt.Errorf("too many decimal digits: %v", d)
-	}
-	if d.Integer > math.MaxUint32 {
-		return fmt.Errorf("value exceeds fixed-point range")
-	}
-
-	val := int64(d.Integer*100 + frac)
-	if d.Negative {
-		val = -val
-		if val < math.MinInt32 {
-			return fmt.Errorf("value exceeds fixed-point range")
-		}
-		*f = Fixed(val)
-		return nil
-	}
-	if val > math.MaxInt32 {
-		return fmt.Errorf("value exceeds fixed-point range")
+	val, err := fixedFromDecimal(d)
+	if err != nil {
+		return err
 	}
-	*f = Fixed(val)
+	*f = val
 	return nil
 }
@@ -2,6 +2,7 @@ package decimal_test
 
 import (
 	"encoding/hex"
+	"os"
 	"strings"
 	"testing"
 
@@ -66,6 +67,39 @@ func TestDecimal_MarshalCBOR_Exact(t *testing.T) {
 	}
 }
 
+func TestDecimal_AppendCBOR(t *testing.T) {
+	values := []decimal.Decimal{
+		{},
+		{Negative: true, Integer: 123},
+		{Integer: 123, Fraction: 123, Digits: 3},
+		{Negative: true, Integer: 123, Fraction: 1234567890123456789, Digits: 19},
+		{Integer: 999999999999999999, Fraction: 9999999999999999999, Digits: 19},
+	}
+	prefix := []byte{0x82}
+	for _, d := range values {
+		want, _ := d.MarshalCBOR()
+		got, err := d.AppendCBOR(prefix)
+		if err != nil {
+			t.Fatalf("AppendCBOR() error = %v", err)
+		}
+		if string(got) != string(prefix)+string(want) {
+			t.Errorf("AppendCBOR(%x) = %x, want %x%x", prefix, got, prefix, want)
+		}
+		buf := make([]byte, 0, 32)
+		if allocs := testing.AllocsPerRun(100, func() { buf, _ = d.AppendCBOR(buf[:0]) }); allocs != 0 {
+			t.Errorf("AppendCBOR(%v) allocates %v times", d, allocs)
+		}
+	}
+}
+
+func BenchmarkDecimal_AppendCBOR(b *testing.B) {
+	d := decimal.Decimal{Integer: 123, Fraction: 123, Digits: 3}
+	buf := make([]byte, 0, 32)
+	for b.Loop() {
+		buf, _ = d.AppendCBOR(buf[:0])
+	}
+}
+
 func TestDecimal_UnmarshalCBOR(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -118,10 +152,10 @@ func TestDecimal_UnmarshalCBOR(t *testing.T) {
 		{"int_trailing", "0100", decimal.Decimal{}, true},
 		{"unsupported_major", "60", decimal.Decimal{}, true},
 		{"unknown_tag", "c1", decimal.Decimal{}, true},
-		{"bignum_tag", "c24101", decimal.Decimal{}, true},
+		{"bignum_tag_overflow", "c249010000000000000000", decimal.Decimal{}, true},
 		{"decfrac_too_short", "c482", decimal.Decimal{}, true},
 		{"decfrac_bad_array", "c48100", decimal.Decimal{}, true},
-		{"decfrac_exp_overflow", "c482181500", decimal.Decimal{}, true},
+		{"decfrac_exp_overflow", "c482181501", decimal.Decimal{}, true},
 		{"float16_wrong_len", "f900", decimal.Decimal{}, true},
 		{"float32_wrong_len", "fa0000", decimal.Decimal{}, true},
 		{"float64_wrong_len", "fb000000", decimal.Decimal{}, true},
@@ -142,6 +176,53 @@ func TestDecimal_UnmarshalCBOR(t *testing.T) {
 		{"decfrac_bignum_pos_exp_mul_overflow", "c48212c248ffffffffffffffff", decimal.Decimal{}, true},
 		{"decfrac_bignum_neg_exp_hi_overflow", "c48220c249ff0000000000000001", decimal.Decimal{}, true},
 		{"bignum_trailing_garbage", "c48232c24942becfe422c061811500", decimal.Decimal{}, true},
+		// non-preferred and indefinite length encodings of representable values
+		{"int_nonpreferred_24", "1801", decimal.Decimal{Integer: 1}, false},
+		{"int_nonpreferred_27", "1b0000000000000001", decimal.Decimal{Integer: 1}, false},
+		{"negative_nonpreferred", "390000", decimal.Decimal{Integer: 1, Negative: true}, false},
+		{"bignum_standalone", "c24101", decimal.Decimal{Integer: 1}, false},
+		{"bignum_negative_standalone", "c34100", decimal.Decimal{Integer: 1, Negative: true}, false},
+		{"bignum_empty", "c240", decimal.Decimal{}, false},
+		{"bignum_max_uint64", "c248ffffffffffffffff", decimal.Decimal{Integer: 18446744073709551615}, false},
+		{"bignum_leading_zeros", "c2 4a 0000000000000000 00ff", decimal.Decimal{Integer: 255}, false},
+		{"bignum_long_leading_zeros", "c2 5814 00000000000000000000 00000000000000000005", decimal.Decimal{Integer: 5}, false},
+		{"bignum_length_nonpreferred", "c2 5801 05", decimal.Decimal{Integer: 5}, false},
+		{"bignum_indefinite", "c2 5f 4101 4102 ff", decimal.Decimal{Integer: 258}, false},
+		{"bignum_indefinite_empty", "c2 5f 40 ff", decimal.Decimal{}, false},
+		{"bignum_tag_nonpreferred", "d802 4105", decimal.Decimal{Integer: 5}, false},
+		{"decfrac_tag_nonpreferred", "d804 82 21 05", decimal.Decimal{Fraction: 5, Digits: 2}, false},
+		{"decfrac_tag_nonpreferred_27", "db0000000000000004 82 21 05", decimal.Decimal{Fraction: 5, Digits: 2}, false},
+		{"decfrac_array_nonpreferred", "c4 9802 21 05", decimal.Decimal{Fraction: 5, Digits: 2}, false},
+		{"decfrac_array_indefinite", "c4 9f 21 05 ff", decimal.Decimal{Fraction: 5, Digits: 2}, false},
+		{"decfrac_exp_nonpreferred", "c4 82 3801 05", decimal.Decimal{Fraction: 5, Digits: 2}, false},
+		{"decfrac_mantissa_nonpreferred", "c4 82 21 1a00000005", decimal.Decimal{Fraction: 5, Digits: 2}, false},
+		{"decfrac_bignum_leading_zeros", "c4 82 21 c2 4400000005", decimal.Decimal{Fraction: 5, Digits: 2}, false},
+		{"decfrac_bignum_indefinite", "c4 82 21 c2 5f 4100 4105 ff", decimal.Decimal{Fraction: 5, Digits: 2}, false},
+		{"decfrac_negative_bignum_small", "c4 82 21 c3 4104", decimal.Decimal{Fraction: 5, Digits: 2, Negative: true}, false},
+		{"decfrac_exponent_below_19", "c4 82 3813 1864", decimal.Decimal{Fraction: 10, Digits: 19}, false},
+		{"decfrac_zero_large_exponent", "c482181500", decimal.Decimal{}, false},
+		{"decfrac_zero_tiny_exponent", "c4 82 3bffffffffffffffff 00", decimal.Decimal{Digits: 19}, false},
+		// malformed or unrepresentable
+		{"decfrac_exponent_below_19_inexact", "c4 82 3813 01", decimal.Decimal{}, true},
+		{"decfrac_tiny_exponent", "c4 82 3bffffffffffffffff 01", decimal.Decimal{}, true},
+		{"decfrac_array_indefinite_missing_break", "c4 9f 21 05", decimal.Decimal{}, true},
+		{"decfrac_array_indefinite_three", "c4 9f 21 05 00 ff", decimal.Decimal{}, true},
+		{"decfrac_array_indefinite_short", "c4 9f 21 ff", decimal.Decimal{}, true},
+		{"decfrac_exponent_bignum", "c4 82 c24101 05", decimal.Decimal{}, true},
+		{"bignum_negative_overflow", "c348ffffffffffffffff", decimal.Decimal{}, true},
+		{"bignum_truncated", "c24401", decimal.Decimal{}, true},
+		{"bignum_length_truncated", "c25b00000001", decimal.Decimal{}, true},
+		{"bignum_length_huge", "c25bffffffffffffffff00", decimal.Decimal{}, true},
+		{"bignum_indefinite_missing_break", "c25f4101", decimal.Decimal{}, true},
+		{"bignum_indefinite_nested", "c25f5fffff", decimal.Decimal{}, true},
+		{"bignum_indefinite_text_chunk", "c25f6100ff", decimal.Decimal{}, true},
+		{"indefinite_integer", "1f", decimal.Decimal{}, true},
+		{"indefinite_tag", "df", decimal.Decimal{}, true},
+		{"reserved_additional", "1e", decimal.Decimal{}, true},
+		{"break", "ff", decimal.Decimal{}, true},
+		{"float_infinity", "f97c00", decimal.Decimal{}, true},
+		{"float_nan", "fb7ff8000000000000", decimal.Decimal{}, true},
+		{"float_too_large", "fa5f800000", decimal.Decimal{}, true},
 	}
 
 	for _, tt := range tests {
@@ -158,6 +239,52 @@ func TestDecimal_UnmarshalCBOR(t *testing.T) {
 	}
 }
 
+func TestDecimal_UnmarshalCBOR_RFC8949(t *testing.T) {
+	data, err := os.ReadFile("testdata/rfc8949-appendix-a.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 {
+			t.Fatalf("invalid corpus line %q", line)
+		}
+		encoded, want, diag := fields[0], fields[1], fields[2]
+		t.Run(encoded, func(t *testing.T) {
+			var got decimal.Decimal
+			err := got.UnmarshalCBOR(mustCBORHex(t, encoded))
+			if want == "error" {
+				if err == nil {
+					t.Errorf("UnmarshalCBOR(%s) = %v, want error", diag, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UnmarshalCBOR(%s) error = %v", diag, err)
+			}
+			if got.String() != want {
+				t.Errorf("UnmarshalCBOR(%s) = %v, want %s", diag, got, want)
+			}
+		})
+	}
+}
+
+func TestDecimal_UnmarshalCBOR_TrailingData(t *testing.T) {
+	for _, h := range []string{"0100", "c4822105ff", "c2410100", "f93e0000"} {
+		d := decimal.Decimal{Integer: 7}
+		err := d.UnmarshalCBOR(mustCBORHex(t, h))
+		if err == nil || !strings.Contains(err.Error(), "1 bytes of trailing data") {
+			t.Errorf("UnmarshalCBOR(%s) error = %v, want trailing data error", h, err)
+		}
+		if d != (decimal.Decimal{Integer: 7}) {
+			t.Errorf("UnmarshalCBOR(%s) modified receiver to %#v", h, d)
+		}
+	}
+}
+
 func BenchmarkDecimal_MarshalCBOR(b *testing.B) {
 	d := decimal.Decimal{Integer: 123, Fraction: 123, Digits: 3}
 	for b.Loop() {
@@ -207,6 +334,23 @@ func TestFixed_MarshalCBOR(t *testing.T) {
 	}
 }
 
+func TestFixed_AppendCBOR(t *testing.T) {
+	for _, f := range []decimal.Fixed{0, 12345, -2147483648} {
+		want, _ := f.MarshalCBOR()
+		got, err := f.AppendCBOR([]byte{0x82})
+		if err != nil {
+			t.Fatalf("AppendCBOR() error = %v", err)
+		}
+		if string(got) != "\x82"+string(want) {
+			t.Errorf("AppendCBOR(82) = %x, want 82%x", got, want)
+		}
+		buf := make([]byte, 0, 16)
+		if allocs := testing.AllocsPerRun(100, func() { buf, _ = f.AppendCBOR(buf[:0]) }); allocs != 0 {
+			t.Errorf("AppendCBOR(%v) allocates %v times", f, allocs)
+		}
+	}
+}
+
 func TestFixed_UnmarshalCBOR(t *testing.T) {
 	tests := []struct {
 		name    string
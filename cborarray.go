@@ -0,0 +1,336 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// RFC 8746 typed arrays use the tags 64 to 87, where the low bits of the tag describe the element type.
+// For the integer arrays (tags 64 to 79) bit 3 marks signed elements, bit 2 little-endian byte order
+// and the lowest two bits give the element size as a power of two in bytes.
+const (
+	cborTypedArrayMin    = 64
+	cborTypedArrayMax    = 79
+	cborTypedArraySigned = 0b1000
+	cborTypedArrayLE     = 0b0100
+	cborTypedArraySint8  = cborTypedArrayMin | cborTypedArraySigned
+	cborTypedArraySint32 = cborTypedArraySint8 | 2
+)
+
+// cborAppendHead appends the head of a data item with the given major type and argument in preferred encoding.
+func cborAppendHead(b []byte, major byte, arg uint64) []byte {
+	var buf [9]byte
+	buf[0] = major
+	n := cborPutInt(arg, buf[:])
+	return append(b, buf[:n]...)
+}
+
+// AppendCBORFixedArray appends the fixed-point values as a decimal fraction with exponent -2 whose mantissa is
+// an RFC 8746 typed array of big-endian int32 values, i.e. 4([-2, 74(h'...')]), and returns the extended buffer.
+// Each element takes exactly 4 bytes. The composition of the two tags is not part of either RFC,
+// so the receiver has to know the format, use `DecodeCBORFixedArray` to decode it.
+func AppendCBORFixedArray(b []byte, s []Fixed) []byte {
+	b = append(b, CBOR_TAG_DECIMALFRAC, CBOR_ARRAY_LEN2, CBOR_INTNEG|1, CBOR_TAG|24, cborTypedArraySint32)
+	b = cborAppendHead(b, CBOR_BYTESTRING, uint64(4*len(s)))
+	for _, f := range s {
+		b = binary.BigEndian.AppendUint32(b, uint32(f))
+	}
+	return b
+}
+
+// AppendCBORDecimalArray appends the decimal values in a packed form with a shared exponent and returns the extended buffer.
+// The exponent is the negated largest number of fractional digits, the mantissas are stored as an RFC 8746 typed array
+// of big-endian signed integers with the smallest width of 1, 2, 4 or 8 bytes that fits all of them,
+// e.g. 4([-2, 72(h'...')]) for prices below 1.28. Decoded values all carry the shared number of fractional digits.
+//
+// If a mantissa does not fit into a signed 64-bit integer the values are encoded as a plain CBOR array of
+// the encodings produced by `AppendCBOR` instead. `DecodeCBORDecimalArray` accepts both forms.
+func AppendCBORDecimalArray(b []byte, s []Decimal) []byte {
+	var digits uint8
+	for _, d := range s {
+		digits = max(digits, d.Digits)
+	}
+	var width uint8 // log2 of the element size in bytes
+	for _, d := range s {
+		m, ok := packedMantissa(d, digits)
+		if !ok {
+			b = cborAppendHead(b, CBOR_ARRAY, uint64(len(s)))
+			for _, d := range s {
+				b, _ = d.AppendCBOR(b) // cannot fail
+			}
+			return b
+		}
+		for width < 3 && (m < -1<<(8<<width-1) || m >= 1<<(8<<width-1)) {
+			width++
+		}
+	}
+
+	b = append(b, CBOR_TAG_DECIMALFRAC, CBOR_ARRAY_LEN2)
+	if digits == 0 {
+		b = append(b, CBOR_INTPOS)
+	} else {
+		b = append(b, CBOR_INTNEG|(digits-1))
+	}
+	b = append(b, CBOR_TAG|24, cborTypedArraySint8|width)
+	b = cborAppendHead(b, CBOR_BYTESTRING, uint64(len(s))<<width)
+	for _, d := range s {
+		m, _ := packedMantissa(d, digits)
+		switch width {
+		case 0:
+			b = append(b, byte(m))
+		case 1:
+			b = binary.BigEndian.AppendUint16(b, uint16(m))
+		case 2:
+			b = binary.BigEndian.AppendUint32(b, uint32(m))
+		default:
+			b = binary.BigEndian.AppendUint64(b, uint64(m))
+		}
+	}
+	return b
+}
+
+// packedMantissa returns the value scaled by 10^digits as a signed 64-bit integer and whether it fits.
+func packedMantissa(d Decimal, digits uint8) (int64, bool) {
+	hi, lo := bits.Mul64(d.Integer, pow10[digits])
+	lo, carry := bits.Add64(lo, d.Fraction*pow10[digits-d.Digits], 0)
+	if hi != 0 || carry != 0 {
+		return 0, false
+	}
+	if d.Negative {
+		if lo > 1<<63 {
+			return 0, false
+		}
+		return -int64(lo), true
+	}
+	if lo > math.MaxInt64 {
+		return 0, false
+	}
+	return int64(lo), true
+}
+
+// cborPackedArray parses a decimal fraction whose mantissa is an RFC 8746 integer typed array.
+// It returns the exponent as sign and magnitude, the typed array tag, the raw elements and the number of bytes consumed.
+func cborPackedArray(buf []byte) (expNeg bool, exp uint64, tag uint64, elems []byte, n int, err error) {
+	major, additional, length, n, err := cborHead(buf)
+	if err != nil {
+		return false, 0, 0, nil, 0, err
+	}
+	if major != CBOR_ARRAY || additional == 31 || length != 2 {
+		return false, 0, 0, nil, 0, fmt.Errorf("cbor: decimal fraction tag not followed by two-element array")
+	}
+	major, _, exp, m, err := cborHead(buf[n:])
+	if err != nil {
+		return false, 0, 0, nil, 0, err
+	}
+	if major != CBOR_INTPOS && major != CBOR_INTNEG {
+		return false, 0, 0, nil, 0, fmt.Errorf("cbor: unexpected major type %d as exponent in decimal fraction", major>>5)
+	}
+	expNeg = major == CBOR_INTNEG
+	if expNeg && exp != math.MaxUint64 {
+		exp++
+	}
+	n += m
+	major, _, tag, m, err = cborHead(buf[n:])
+	if err != nil {
+		return false, 0, 0, nil, 0, err
+	}
+	if major != CBOR_TAG || tag < cborTypedArrayMin || tag > cborTypedArrayMax {
+		return false, 0, 0, nil, 0, fmt.Errorf("cbor: decimal fraction mantissa is not an integer typed array")
+	}
+	n += m
+	major, additional, length, m, err = cborHead(buf[n:])
+	if err != nil {
+		return false, 0, 0, nil, 0, err
+	}
+	if major != CBOR_BYTESTRING || additional == 31 {
+		return false, 0, 0, nil, 0, fmt.Errorf("cbor: typed array tag %d is not followed by a definite length bytestring", tag)
+	}
+	n += m
+	if uint64(len(buf)-n) < length {
+		return false, 0, 0, nil, 0, fmt.Errorf("cbor: not enough data for typed array of %d bytes", length)
+	}
+	if length%(1<<(tag&3)) != 0 {
+		return false, 0, 0, nil, 0, fmt.Errorf("cbor: typed array of %d bytes is not a multiple of its element size %d", length, 1<<(tag&3))
+	}
+	return expNeg, exp, tag, buf[n : n+int(length)], n + int(length), nil
+}
+
+// typedArrayElement returns the sign and magnitude of the i-th element of an RFC 8746 integer typed array.
+func typedArrayElement(tag uint64, elems []byte, i int) (bool, uint64) {
+	size := 1 << (tag & 3)
+	e := elems[i*size : (i+1)*size]
+	var v uint64
+	switch {
+	case size == 1:
+		v = uint64(e[0])
+	case tag&cborTypedArrayLE != 0:
+		for j := size - 1; j >= 0; j-- {
+			v = v<<8 | uint64(e[j])
+		}
+	default:
+		v = getGroup(e)
+	}
+	if tag&cborTypedArraySigned == 0 {
+		return false, v
+	}
+	shift := 64 - 8*size
+	s := int64(v<<shift) >> shift
+	if s < 0 {
+		return true, uint64(-s)
+	}
+	return false, uint64(s)
+}
+
+// cborDecodeArray decodes a packed decimal fraction array or a plain CBOR array of values,
+// calling add for each element, and checks that the data contains exactly one item.
+func cborDecodeArray(data []byte, grow func(n int), add func(d Decimal) error) error {
+	major, additional, arg, n, err := cborHead(data)
+	if err != nil {
+		return err
+	}
+	switch {
+	case major == CBOR_TAG && arg == CBOR_TAG_DECIMALFRAC&CBOR_ADDITIONAL:
+		expNeg, exp, tag, elems, m, err := cborPackedArray(data[n:])
+		if err != nil {
+			return err
+		}
+		n += m
+		count := len(elems) >> (tag & 3)
+		grow(count)
+		for i := range count {
+			neg, mag := typedArrayElement(tag, elems, i)
+			d, err := cborScaled(neg, 0, mag, expNeg, exp)
+			if err != nil {
+				return err
+			}
+			if err := add(d); err != nil {
+				return err
+			}
+		}
+	case major == CBOR_ARRAY:
+		if additional == 31 {
+			grow(0)
+		} else {
+			grow(int(min(arg, uint64(len(data)-n))))
+		}
+		for i := uint64(0); additional == 31 || i < arg; i++ {
+			if additional == 31 {
+				if n >= len(data) {
+					return fmt.Errorf("cbor: unexpected end of data in indefinite length array")
+				}
+				if data[n] == CBOR_BREAK {
+					n++
+					break
+				}
+			}
+			d, m, err := cborDecode(data[n:])
+			if err != nil {
+				return err
+			}
+			n += m
+			if err := add(d); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("cbor: expected array or packed decimal fraction, got major type %d", major>>5)
+	}
+	if n != len(data) {
+		return fmt.Errorf("cbor: %d bytes of trailing data after value", len(data)-n)
+	}
+	return nil
+}
+
+// DecodeCBORDecimalArray decodes values encoded by `AppendCBORDecimalArray` or `AppendCBORFixedArray`.
+// It accepts a decimal fraction whose mantissa is any RFC 8746 integer typed array as well as a plain CBOR array of values
+// in any encoding accepted by `Decimal.UnmarshalCBOR`. The data must contain exactly one item.
+func DecodeCBORDecimalArray(data []byte) ([]Decimal, error) {
+	var s []Decimal
+	err := cborDecodeArray(data, func(n int) { s = make([]Decimal, 0, n) }, func(d Decimal) error {
+		s = append(s, d)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DecodeCBORFixedArray decodes values encoded by `AppendCBORFixedArray` or any other form accepted by `DecodeCBORDecimalArray`.
+// Elements with more than two fractional digits or outside the fixed-point range return an error.
+func DecodeCBORFixedArray(data []byte) ([]Fixed, error) {
+	if s, ok := decodeCBORFixedArrayFast(data); ok {
+		return s, nil
+	}
+	var s []Fixed
+	err := cborDecodeArray(data, func(n int) { s = make([]Fixed, 0, n) }, func(d Decimal) error {
+		f, err := fixedFromDecimal(d)
+		s = append(s, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// decodeCBORFixedArrayFast decodes the exact output of `AppendCBORFixedArray` without going through Decimal.
+func decodeCBORFixedArrayFast(data []byte) ([]Fixed, bool) {
+	const prefix = 5 // tag 4, array of two, exponent -2, tag 74
+	if len(data) < prefix+1 || data[0] != CBOR_TAG_DECIMALFRAC || data[1] != CBOR_ARRAY_LEN2 || data[2] != CBOR_INTNEG|1 ||
+		data[3] != CBOR_TAG|24 || data[4] != cborTypedArraySint32 {
+		return nil, false
+	}
+	major, additional, length, n, err := cborHead(data[prefix:])
+	if err != nil || major != CBOR_BYTESTRING || additional == 31 || length%4 != 0 || uint64(len(data)-prefix-n) != length {
+		return nil, false
+	}
+	elems := data[prefix+n:]
+	s := make([]Fixed, len(elems)/4)
+	for i := range s {
+		s[i] = Fixed(int32(binary.BigEndian.Uint32(elems[4*i:])))
+	}
+	return s, true
+}
+
+// CBORFixedArray is a slice of fixed-point values that is encoded as a packed typed array, see `AppendCBORFixedArray`.
+// Convert slices with `CBORFixedArray(s)` when encoding and decode into `(*CBORFixedArray)(&s)`.
+type CBORFixedArray []Fixed
+
+// MarshalCBOR implements the cbor.Marshaler interface.
+func (a CBORFixedArray) MarshalCBOR() ([]byte, error) {
+	return AppendCBORFixedArray(make([]byte, 0, 7+4*len(a)), a), nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface as described by `DecodeCBORFixedArray`.
+// The receiver is left unchanged if an error is returned.
+func (a *CBORFixedArray) UnmarshalCBOR(data []byte) error {
+	s, err := DecodeCBORFixedArray(data)
+	if err != nil {
+		return err
+	}
+	*a = s
+	return nil
+}
+
+// CBORDecimalArray is a slice of decimal values that is encoded in the packed form with a shared exponent,
+// see `AppendCBORDecimalArray`. Convert slices with `CBORDecimalArray(s)` when encoding and decode into `(*CBORDecimalArray)(&s)`.
+type CBORDecimalArray []Decimal
+
+// MarshalCBOR implements the cbor.Marshaler interface.
+func (a CBORDecimalArray) MarshalCBOR() ([]byte, error) {
+	return AppendCBORDecimalArray(nil, a), nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface as described by `DecodeCBORDecimalArray`.
+// The receiver is left unchanged if an error is returned.
+func (a *CBORDecimalArray) UnmarshalCBOR(data []byte) error {
+	s, err := DecodeCBORDecimalArray(data)
+	if err != nil {
+		return err
+	}
+	*a = s
+	return nil
+}
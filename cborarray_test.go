@@ -0,0 +1,193 @@
+package decimal_test
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestAppendCBORFixedArray(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []decimal.Fixed
+		want string
+	}{
+		{"empty", nil, "c48221d84a40"},
+		{"values", []decimal.Fixed{1250, -1, 0}, "c48221d84a4c000004e2ffffffff00000000"},
+		{"limits", []decimal.Fixed{2147483647, -2147483648}, "c48221d84a487fffffff80000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decimal.AppendCBORFixedArray([]byte{0x01}, tt.s)
+			if hex.EncodeToString(got) != "01"+tt.want {
+				t.Fatalf("AppendCBORFixedArray() = %x, want 01%s", got, tt.want)
+			}
+			back, err := decimal.DecodeCBORFixedArray(got[1:])
+			if err != nil || len(back) != len(tt.s) || (len(back) > 0 && !reflect.DeepEqual(back, tt.s)) {
+				t.Errorf("DecodeCBORFixedArray(%x) = %v, %v, want %v", got[1:], back, err, tt.s)
+			}
+		})
+	}
+}
+
+func TestAppendCBORDecimalArray(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []decimal.Decimal
+		want string
+		back []decimal.Decimal
+	}{
+		{"empty", nil, "c48200d84840", []decimal.Decimal{}},
+		{"int8", []decimal.Decimal{{Integer: 1}, {Integer: 2}, {Negative: true, Integer: 128}}, "c48200d84843010280", nil},
+		{"int16", []decimal.Decimal{{Integer: 1, Fraction: 5, Digits: 1}, {Integer: 2, Fraction: 25, Digits: 2}, {Negative: true, Fraction: 1, Digits: 2}},
+			"c48221d84946009600e1ffff",
+			[]decimal.Decimal{{Integer: 1, Fraction: 50, Digits: 2}, {Integer: 2, Fraction: 25, Digits: 2}, {Negative: true, Fraction: 1, Digits: 2}}},
+		{"int32", []decimal.Decimal{{Integer: 21474836, Fraction: 47, Digits: 2}}, "c48221d84a447fffffff", nil},
+		{"int64", []decimal.Decimal{{Integer: 9223372036854775807}, {Negative: true, Integer: 9223372036854775808}}, "c48200d84b507fffffffffffffff8000000000000000", nil},
+		{"fallback_uint64", []decimal.Decimal{{Integer: 9223372036854775808}}, "811b8000000000000000", nil},
+		{"fallback_digits", []decimal.Decimal{{Integer: 10}, {Fraction: 1, Digits: 19}}, "820ac4823201", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decimal.AppendCBORDecimalArray([]byte{0x01}, tt.s)
+			if hex.EncodeToString(got) != "01"+tt.want {
+				t.Fatalf("AppendCBORDecimalArray() = %x, want 01%s", got, tt.want)
+			}
+			want := tt.back
+			if want == nil {
+				want = tt.s
+			}
+			back, err := decimal.DecodeCBORDecimalArray(got[1:])
+			if err != nil || !reflect.DeepEqual(back, want) {
+				t.Errorf("DecodeCBORDecimalArray(%x) = %v, %v, want %v", got[1:], back, err, want)
+			}
+		})
+	}
+}
+
+func TestDecodeCBORDecimalArray(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    []decimal.Decimal
+		wantErr bool
+	}{
+		{"uint16_little_endian", "c48220d845440100ff00", []decimal.Decimal{{Fraction: 1, Digits: 1}, {Integer: 25, Fraction: 5, Digits: 1}}, false},
+		{"uint64", "c48200d84348ffffffffffffffff", []decimal.Decimal{{Integer: 18446744073709551615}}, false},
+		{"sint32_little_endian", "c48221d84e44feffffff", []decimal.Decimal{{Negative: true, Fraction: 2, Digits: 2}}, false},
+		{"positive_exponent", "c48202d8484105", []decimal.Decimal{{Integer: 500}}, false},
+		{"plain_array", "8301c4822105f93e00", []decimal.Decimal{{Integer: 1}, {Fraction: 5, Digits: 2}, {Integer: 1, Fraction: 5, Digits: 1}}, false},
+		{"indefinite_array", "9f01ff", []decimal.Decimal{{Integer: 1}}, false},
+		{"empty_indefinite_array", "9fff", []decimal.Decimal{}, false},
+		{"bytes_not_multiple", "c48221d84a43010203", nil, true},
+		{"bytes_truncated", "c48221d84a4400", nil, true},
+		{"float_typed_array", "c48221d8514400000000", nil, true},
+		{"single_value", "c4822105", nil, true},
+		{"indefinite_bytes", "c48221d8485f4101ff", nil, true},
+		{"overflow", "c48202d84b487fffffffffffffff", nil, true},
+		{"trailing", "c48221d84a4000", nil, true},
+		{"array_trailing", "810100", nil, true},
+		{"array_truncated", "8201", nil, true},
+		{"indefinite_array_unterminated", "9f01", nil, true},
+		{"not_array", "01", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decimal.DecodeCBORDecimalArray(mustCBORHex(t, tt.hex))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeCBORDecimalArray() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeCBORDecimalArray() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeCBORFixedArray(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    []decimal.Fixed
+		wantErr bool
+	}{
+		{"packed_decimal", "c48222d848420a14", []decimal.Fixed{1, 2}, false},
+		{"int16", "c48221d84946009600e1ffff", []decimal.Fixed{150, 225, -1}, false},
+		{"plain_array", "8201c4822105", []decimal.Fixed{100, 5}, false},
+		{"nonpreferred_length", "c48221d84a5804000004e2", []decimal.Fixed{1250}, false},
+		{"sub_cent", "c48222d8484101", nil, true},
+		{"out_of_range", "c48221d84b480000000080000000", nil, true},
+		{"trailing", "c48221d84a4400000001ff", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decimal.DecodeCBORFixedArray(mustCBORHex(t, tt.hex))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeCBORFixedArray() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeCBORFixedArray() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCBORArrayWrappers(t *testing.T) {
+	fixed := []decimal.Fixed{1250, -1}
+	data, err := decimal.CBORFixedArray(fixed).MarshalCBOR()
+	if err != nil || hex.EncodeToString(data) != "c48221d84a48000004e2ffffffff" {
+		t.Fatalf("CBORFixedArray.MarshalCBOR() = %x, %v", data, err)
+	}
+	var fixedBack []decimal.Fixed
+	if err := (*decimal.CBORFixedArray)(&fixedBack).UnmarshalCBOR(data); err != nil || !reflect.DeepEqual(fixedBack, fixed) {
+		t.Errorf("CBORFixedArray.UnmarshalCBOR() = %v, %v, want %v", fixedBack, err, fixed)
+	}
+	if err := (*decimal.CBORFixedArray)(&fixedBack).UnmarshalCBOR([]byte{0x01}); err == nil || !reflect.DeepEqual(fixedBack, fixed) {
+		t.Errorf("CBORFixedArray.UnmarshalCBOR(01) = %v, %v, want error and unchanged receiver", fixedBack, err)
+	}
+
+	decimals := []decimal.Decimal{{Integer: 1, Fraction: 5, Digits: 1}, {Integer: 3, Digits: 1}}
+	data, err = decimal.CBORDecimalArray(decimals).MarshalCBOR()
+	if err != nil || hex.EncodeToString(data) != "c48220d848420f1e" {
+		t.Fatalf("CBORDecimalArray.MarshalCBOR() = %x, %v", data, err)
+	}
+	var decimalBack []decimal.Decimal
+	if err := (*decimal.CBORDecimalArray)(&decimalBack).UnmarshalCBOR(data); err != nil || !reflect.DeepEqual(decimalBack, decimals) {
+		t.Errorf("CBORDecimalArray.UnmarshalCBOR() = %v, %v, want %v", decimalBack, err, decimals)
+	}
+}
+
+func BenchmarkAppendCBORFixedArray(b *testing.B) {
+	s := make([]decimal.Fixed, 1000)
+	for i := range s {
+		s[i] = decimal.Fixed(i * 137)
+	}
+	buf := make([]byte, 0, 8+4*len(s))
+	for b.Loop() {
+		buf = decimal.AppendCBORFixedArray(buf[:0], s)
+	}
+}
+
+func BenchmarkDecodeCBORFixedArray(b *testing.B) {
+	s := make([]decimal.Fixed, 1000)
+	for i := range s {
+		s[i] = decimal.Fixed(i * 137)
+	}
+	data := decimal.AppendCBORFixedArray(nil, s)
+	for b.Loop() {
+		_, _ = decimal.DecodeCBORFixedArray(data)
+	}
+}
+
+func BenchmarkDecodeCBORDecimalArray(b *testing.B) {
+	s := make([]decimal.Decimal, 1000)
+	for i := range s {
+		s[i] = decimal.Decimal{Integer: uint64(i), Fraction: uint64(i % 100), Digits: 2}
+	}
+	data := decimal.AppendCBORDecimalArray(nil, s)
+	for b.Loop() {
+		_, _ = decimal.DecodeCBORDecimalArray(data)
+	}
+}
@@ -0,0 +1,68 @@
+package decimal
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// AppendCBORBigfloat appends the value as a CBOR bigfloat (RFC 8949 Section 3.4.4, tag 5) to b and returns the extended buffer.
+// A bigfloat is a two-element array [exponent, mantissa] with the value mantissa × 2^exponent, e.g. 1.5 is encoded as [-1, 3].
+// Only values that are exact binary fractions such as 0.375 can be encoded, other values such as 0.1 return an error.
+// Integers are encoded as CBOR integers like `AppendCBOR` does. The number of fractional digits is not preserved.
+func (d Decimal) AppendCBORBigfloat(b []byte) ([]byte, error) {
+	d = d.Truncate()
+	if d.Digits == 0 {
+		return d.AppendCBOR(b)
+	}
+	qhi, qlo, ok := d.binaryMantissa()
+	if !ok {
+		return b, fmt.Errorf("cbor: %v is not an exact binary fraction", d)
+	}
+
+	var arr [24]byte
+	arr[0] = CBOR_TAG_BIGFLOAT
+	arr[1] = CBOR_ARRAY_LEN2
+	arr[2] = CBOR_INTNEG | (d.Digits - 1)
+	n := cborPutMantissa(d.Negative, qhi, qlo, arr[3:])
+	return append(b, arr[:3+n]...), nil
+}
+
+// binaryMantissa returns the 128-bit mantissa m of a truncated value with k fractional digits such that the value is m × 2^-k.
+// ok is false if the value is not an exact binary fraction.
+func (d Decimal) binaryMantissa() (hi, lo uint64, ok bool) {
+	// The value is N / 10^k = (N / 5^k) / 2^k with k fractional digits, so it is a binary fraction if N is divisible by 5^k.
+	// N has no trailing zero after truncation, so N / 5^k is odd unless k is zero.
+	nhi, nlo := bits.Mul64(d.Integer, pow10[d.Digits])
+	nlo, carry := bits.Add64(nlo, d.Fraction, 0)
+	nhi += carry
+	pow5 := pow10[d.Digits] >> d.Digits
+	hi, r := nhi/pow5, nhi%pow5
+	lo, r = bits.Div64(r, nlo, pow5)
+	return hi, lo, r == 0
+}
+
+// AppendCBORBigfloat appends the fixed-point value as a CBOR bigfloat as described by `Decimal.AppendCBORBigfloat`.
+// Only multiples of 0.25 are exact binary fractions.
+func (f Fixed) AppendCBORBigfloat(b []byte) ([]byte, error) {
+	return f.Decimal().AppendCBORBigfloat(b)
+}
+
+// CBORBigfloat is a decimal value that is encoded as a CBOR bigfloat (tag 5), see `Decimal.AppendCBORBigfloat`.
+// Convert values with `CBORBigfloat(d)` when encoding and decode into `(*CBORBigfloat)(&d)`.
+// Encoding fails for values that are not exact binary fractions, decoding accepts everything `Decimal.UnmarshalCBOR` does.
+type CBORBigfloat Decimal
+
+// MarshalCBOR implements the cbor.Marshaler interface.
+func (c CBORBigfloat) MarshalCBOR() ([]byte, error) {
+	return Decimal(c).AppendCBORBigfloat(nil)
+}
+
+// AppendCBOR appends the CBOR encoding of the value as produced by `MarshalCBOR` to b and returns the extended buffer.
+func (c CBORBigfloat) AppendCBOR(b []byte) ([]byte, error) {
+	return Decimal(c).AppendCBORBigfloat(b)
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface as described by `Decimal.UnmarshalCBOR`.
+func (c *CBORBigfloat) UnmarshalCBOR(data []byte) error {
+	return (*Decimal)(c).UnmarshalCBOR(data)
+}
@@ -0,0 +1,137 @@
+package decimal_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestDecimal_UnmarshalCBOR_Bigfloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"one_point_five", "c5822003", decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, false},
+		{"three_eighths", "c5822203", decimal.Decimal{Fraction: 375, Digits: 3}, false},
+		{"negative", "c5822023", decimal.Decimal{Negative: true, Integer: 2}, false},
+		{"positive_exponent", "c5820103", decimal.Decimal{Integer: 6}, false},
+		{"zero_exponent", "c5820003", decimal.Decimal{Integer: 3}, false},
+		{"zero", "c5822000", decimal.Decimal{}, false},
+		{"max_shift", "c582183f01", decimal.Decimal{Integer: 1 << 63}, false},
+		{"even_mantissa", "c582381302", decimal.Decimal{Fraction: 19073486328125, Digits: 19}, false},
+		{"max_digits", "c582381201", decimal.Decimal{Fraction: 19073486328125, Digits: 19}, false},
+		{"max_mantissa", "c582211bffffffffffffffff", decimal.Decimal{Integer: 4611686018427387903, Fraction: 75, Digits: 2}, false},
+		{"bignum_mantissa", "c58220c24103", decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, false},
+		{"large_bignum_mantissa", "c58220c249010000000000000001", decimal.Decimal{Integer: 9223372036854775808, Fraction: 5, Digits: 1}, false},
+		{"indefinite_array", "c59f2003ff", decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, false},
+		{"tag_nonpreferred", "d805822003", decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, false},
+		{"shift_overflow", "c582184001", decimal.Decimal{}, true},
+		{"positive_exponent_overflow", "c582011bffffffffffffffff", decimal.Decimal{}, true},
+		{"bignum_overflow", "c58220c249020000000000000000", decimal.Decimal{}, true},
+		{"too_many_digits", "c582381301", decimal.Decimal{}, true},
+		{"tiny_exponent", "c5823bffffffffffffffff01", decimal.Decimal{}, true},
+		{"bad_array", "c58120", decimal.Decimal{}, true},
+		{"float_exponent", "c582f9000003", decimal.Decimal{}, true},
+		{"trailing", "c582200300", decimal.Decimal{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got decimal.Decimal
+			err := got.UnmarshalCBOR(mustCBORHex(t, tt.hex))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalCBOR() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalCBOR() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_AppendCBORBigfloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       decimal.Decimal
+		hex     string
+		wantErr bool
+	}{
+		{"one_point_five", decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, "c5822003", false},
+		{"trailing_zeros", decimal.Decimal{Integer: 1, Fraction: 50, Digits: 2}, "c5822003", false},
+		{"three_eighths", decimal.Decimal{Fraction: 375, Digits: 3}, "c5822203", false},
+		{"negative", decimal.Decimal{Negative: true, Integer: 1, Fraction: 5, Digits: 1}, "c5822022", false},
+		{"integer", decimal.Decimal{Integer: 12, Fraction: 0, Digits: 2}, "0c", false},
+		{"negative_zero", decimal.Decimal{Negative: true, Digits: 2}, "00", false},
+		{"max_digits", decimal.Decimal{Fraction: 19073486328125, Digits: 19}, "c5823201", false},
+		{"bignum", decimal.Decimal{Integer: 18446744073709551615, Fraction: 5, Digits: 1}, "c58220c24901ffffffffffffffff", false},
+		{"negative_bignum", decimal.Decimal{Negative: true, Integer: 18446744073709551615, Fraction: 5, Digits: 1}, "c58220c34901fffffffffffffffe", false},
+		{"tenth", decimal.Decimal{Fraction: 1, Digits: 1}, "", true},
+		{"cent", decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.AppendCBORBigfloat([]byte{0x82})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AppendCBORBigfloat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			want := "82" + tt.hex
+			if hex.EncodeToString(got) != want {
+				t.Fatalf("AppendCBORBigfloat() = %x, want %s", got, want)
+			}
+			if tt.wantErr {
+				return
+			}
+			var back decimal.Decimal
+			if err := back.UnmarshalCBOR(got[1:]); err != nil || back != tt.d.Canonical() {
+				t.Errorf("UnmarshalCBOR(%x) = %v, %v, want %v", got[1:], back, err, tt.d)
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_AppendCBORBigfloat(b *testing.B) {
+	d := decimal.Decimal{Integer: 123, Fraction: 375, Digits: 3}
+	buf := make([]byte, 0, 32)
+	for b.Loop() {
+		buf, _ = d.AppendCBORBigfloat(buf[:0])
+	}
+}
+
+func TestFixed_AppendCBORBigfloat(t *testing.T) {
+	tests := []struct {
+		f       decimal.Fixed
+		hex     string
+		wantErr bool
+	}{
+		{125, "c5822105", false},
+		{-50, "c5822020", false},
+		{300, "03", false},
+		{10, "", true},
+	}
+	for _, tt := range tests {
+		got, err := tt.f.AppendCBORBigfloat(nil)
+		if (err != nil) != tt.wantErr || hex.EncodeToString(got) != tt.hex {
+			t.Errorf("Fixed(%d).AppendCBORBigfloat() = %x, %v, want %s, error %v", int32(tt.f), got, err, tt.hex, tt.wantErr)
+		}
+	}
+}
+
+func TestCBORBigfloat(t *testing.T) {
+	d := decimal.Decimal{Integer: 2, Fraction: 625, Digits: 3}
+	data, err := decimal.CBORBigfloat(d).MarshalCBOR()
+	if err != nil || hex.EncodeToString(data) != "c5822215" {
+		t.Fatalf("CBORBigfloat.MarshalCBOR() = %x, %v, want c5822215", data, err)
+	}
+	if data, err = decimal.CBORBigfloat(d).AppendCBOR([]byte{0x01}); err != nil || hex.EncodeToString(data) != "01c5822215" {
+		t.Fatalf("CBORBigfloat.AppendCBOR() = %x, %v, want 01c5822215", data, err)
+	}
+	var back decimal.Decimal
+	if err := (*decimal.CBORBigfloat)(&back).UnmarshalCBOR(data[1:]); err != nil || back != d {
+		t.Errorf("CBORBigfloat.UnmarshalCBOR() = %#v, %v, want %#v", back, err, d)
+	}
+	if _, err := decimal.CBORBigfloat(decimal.Decimal{Fraction: 3, Digits: 1}).MarshalCBOR(); err == nil {
+		t.Errorf("CBORBigfloat(0.3).MarshalCBOR() succeeded, want error")
+	}
+}
@@ -0,0 +1,670 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/x448/float16"
+)
+
+// cborMaxDepth limits the nesting of arrays, maps and tags handled by diagnostic notation, bounding the recursion.
+const cborMaxDepth = 256
+
+// CBORDiagnostic returns the RFC 8949 diagnostic notation of the encoding produced by `MarshalCBOR`,
+// e.g. "4([-2, 12345])" for 123.45 or "1250" for an integer.
+func (d Decimal) CBORDiagnostic() string {
+	var arr [24]byte
+	b, _ := d.AppendCBOR(arr[:0])
+	s, _, _ := AppendCBORDiagnostic(make([]byte, 0, 32), b) // cannot fail for valid encodings
+	return string(s)
+}
+
+// CBORDiagnostic returns the RFC 8949 diagnostic notation of the encoding produced by `MarshalCBOR`, e.g. "4([-2, 1250])".
+func (f Fixed) CBORDiagnostic() string {
+	return f.Decimal().CBORDiagnostic()
+}
+
+// NewFromCBORDiagnostic parses a value in diagnostic notation as described by `ParseCBORDiagnostic`
+// and decodes it as described by `Decimal.UnmarshalCBOR`.
+func NewFromCBORDiagnostic(s string) (Decimal, error) {
+	b, err := ParseCBORDiagnostic(s)
+	if err != nil {
+		return Zero(), err
+	}
+	var d Decimal
+	if err := d.UnmarshalCBOR(b); err != nil {
+		return Zero(), err
+	}
+	return d, nil
+}
+
+// AppendCBORDiagnostic appends the RFC 8949 Section 8 diagnostic notation of the first data item in data to dst.
+// It returns the extended buffer and the number of bytes of data that were consumed, so CBOR sequences can be printed item by item.
+//
+// All well-formed data items are supported. Tags are printed generically as "tag(content)", byte strings as h'hex',
+// floats like ECMAScript numbers with a ".0" for integral values, as well as Infinity and NaN.
+// Non-preferred argument encodings are marked with the encoding indicators _0 to _3, indefinite lengths with "_".
+// The notation has no syntax for the sign and payload of a NaN, every NaN is printed as NaN.
+func AppendCBORDiagnostic(dst, data []byte) ([]byte, int, error) {
+	return cborDiagItem(dst, data, 0)
+}
+
+// cborIndicator appends the encoding indicator for an argument that is not encoded in its preferred form.
+func cborIndicator(dst []byte, additional byte, arg uint64) []byte {
+	if additional < 24 || additional > 27 {
+		return dst
+	}
+	var preferred byte
+	switch {
+	case arg < 24:
+		preferred = 0
+	case arg <= math.MaxUint8:
+		preferred = 24
+	case arg <= math.MaxUint16:
+		preferred = 25
+	case arg <= math.MaxUint32:
+		preferred = 26
+	default:
+		preferred = 27
+	}
+	if additional == preferred {
+		return dst
+	}
+	return append(dst, '_', '0'+additional-24)
+}
+
+func cborDiagItem(dst, buf []byte, depth int) ([]byte, int, error) {
+	if depth > cborMaxDepth {
+		return dst, 0, fmt.Errorf("cbor: nesting exceeds %d levels", cborMaxDepth)
+	}
+	major, additional, arg, n, err := cborHead(buf)
+	if err != nil {
+		return dst, 0, err
+	}
+	switch major {
+	case CBOR_INTPOS:
+		dst = strconv.AppendUint(dst, arg, 10)
+		return cborIndicator(dst, additional, arg), n, nil
+	case CBOR_INTNEG:
+		if arg == math.MaxUint64 {
+			dst = append(dst, "-18446744073709551616"...)
+		} else {
+			dst = append(dst, '-')
+			dst = strconv.AppendUint(dst, arg+1, 10)
+		}
+		return cborIndicator(dst, additional, arg), n, nil
+	case CBOR_BYTESTRING, CBOR_TEXTSTRING:
+		if additional != 31 {
+			if uint64(len(buf)-n) < arg {
+				return dst, 0, fmt.Errorf("cbor: not enough data for string of %d bytes", arg)
+			}
+			dst, err = cborDiagString(dst, major, buf[n:n+int(arg)])
+			return cborIndicator(dst, additional, arg), n + int(arg), err
+		}
+		if n < len(buf) && buf[n] == CBOR_BREAK {
+			// Empty indefinite length strings have no chunks that would show the string type.
+			if major == CBOR_BYTESTRING {
+				return append(dst, "''_"...), n + 1, nil
+			}
+			return append(dst, `""_`...), n + 1, nil
+		}
+		dst = append(dst, "(_ "...)
+		for first := true; ; first = false {
+			if n >= len(buf) {
+				return dst, 0, fmt.Errorf("cbor: unexpected end of data in indefinite length string")
+			}
+			if buf[n] == CBOR_BREAK {
+				return append(dst, ')'), n + 1, nil
+			}
+			chunkMajor, chunkAdditional, _, _, err := cborHead(buf[n:])
+			if err != nil {
+				return dst, 0, err
+			}
+			if chunkMajor != major || chunkAdditional == 31 {
+				return dst, 0, fmt.Errorf("cbor: indefinite length string contains invalid chunk")
+			}
+			if !first {
+				dst = append(dst, ", "...)
+			}
+			var m int
+			if dst, m, err = cborDiagItem(dst, buf[n:], depth+1); err != nil {
+				return dst, 0, err
+			}
+			n += m
+		}
+	case CBOR_ARRAY, CBOR_MAP:
+		open, close := byte('['), byte(']')
+		if major == CBOR_MAP {
+			open, close = '{', '}'
+		}
+		dst = append(dst, open)
+		if additional == 31 {
+			dst = append(dst, "_ "...)
+		} else if l := len(dst); len(cborIndicator(dst, additional, arg)) != l {
+			dst = append(cborIndicator(dst, additional, arg), ' ')
+		}
+		for i := uint64(0); additional == 31 || i < arg; i++ {
+			if additional == 31 {
+				if n >= len(buf) {
+					return dst, 0, fmt.Errorf("cbor: unexpected end of data in indefinite length container")
+				}
+				if buf[n] == CBOR_BREAK {
+					n++
+					break
+				}
+			}
+			if i > 0 {
+				dst = append(dst, ", "...)
+			}
+			var m int
+			if dst, m, err = cborDiagItem(dst, buf[n:], depth+1); err != nil {
+				return dst, 0, err
+			}
+			n += m
+			if major == CBOR_MAP {
+				dst = append(dst, ": "...)
+				if dst, m, err = cborDiagItem(dst, buf[n:], depth+1); err != nil {
+					return dst, 0, err
+				}
+				n += m
+			}
+		}
+		return append(dst, close), n, nil
+	case CBOR_TAG:
+		dst = strconv.AppendUint(dst, arg, 10)
+		dst = cborIndicator(dst, additional, arg)
+		dst = append(dst, '(')
+		dst, m, err := cborDiagItem(dst, buf[n:], depth+1)
+		if err != nil {
+			return dst, 0, err
+		}
+		return append(dst, ')'), n + m, nil
+	default:
+		switch additional {
+		case 20:
+			return append(dst, "false"...), n, nil
+		case 21:
+			return append(dst, "true"...), n, nil
+		case 22:
+			return append(dst, "null"...), n, nil
+		case 23:
+			return append(dst, "undefined"...), n, nil
+		case 24:
+			if arg < 32 {
+				return dst, 0, fmt.Errorf("cbor: invalid two-byte simple value %d", arg)
+			}
+		case 25:
+			return cborDiagFloat(dst, float64(float16.Frombits(uint16(arg)).Float32()), ""), n, nil
+		case 26:
+			f := math.Float32frombits(uint32(arg))
+			indicator := ""
+			if cborFloat16Exact(f) {
+				indicator = "_2"
+			}
+			return cborDiagFloat(dst, float64(f), indicator), n, nil
+		case 27:
+			f := math.Float64frombits(arg)
+			indicator := ""
+			if float64(float32(f)) == f || math.IsNaN(f) {
+				indicator = "_3"
+			}
+			return cborDiagFloat(dst, f, indicator), n, nil
+		case 31:
+			return dst, 0, fmt.Errorf("cbor: unexpected break")
+		}
+		dst = append(dst, "simple("...)
+		dst = strconv.AppendUint(dst, arg, 10)
+		return append(dst, ')'), n, nil
+	}
+}
+
+// cborFloat16Exact reports whether a float16 represents the value exactly. NaN is always considered exact.
+func cborFloat16Exact(f float32) bool {
+	return f != f || float16.Fromfloat32(f).Float32() == f
+}
+
+// cborDiagFloat appends a float in diagnostic notation followed by the encoding indicator.
+func cborDiagFloat(dst []byte, f float64, indicator string) []byte {
+	switch {
+	case math.IsNaN(f):
+		dst = append(dst, "NaN"...)
+	case math.IsInf(f, 1):
+		dst = append(dst, "Infinity"...)
+	case math.IsInf(f, -1):
+		dst = append(dst, "-Infinity"...)
+	default:
+		// Floats are written like ECMAScript numbers but always carry a fraction, e.g. 1.0, 0.00006103515625 and 1.0e+300.
+		var buf [32]byte
+		start := len(dst)
+		dst = appendECMAScriptFloat(dst, strconv.AppendFloat(buf[:0], f, 'e', -1, 64))
+		if !bytes.ContainsRune(dst[start:], '.') {
+			if i := bytes.IndexByte(dst[start:], 'e'); i >= 0 {
+				dst = slices.Insert(dst, start+i, '.', '0')
+			} else {
+				dst = append(dst, ".0"...)
+			}
+		}
+	}
+	return append(dst, indicator...)
+}
+
+// cborDiagString appends a byte string as h'hex' or a text string as a quoted string with JSON escapes.
+func cborDiagString(dst []byte, major byte, s []byte) ([]byte, error) {
+	if major == CBOR_BYTESTRING {
+		dst = append(dst, "h'"...)
+		dst = hex.AppendEncode(dst, s)
+		return append(dst, '\''), nil
+	}
+	if !utf8.Valid(s) {
+		return dst, fmt.Errorf("cbor: invalid UTF-8 in text string")
+	}
+	dst = append(dst, '"')
+	for _, c := range s {
+		switch {
+		case c == '"' || c == '\\':
+			dst = append(dst, '\\', c)
+		case c == '\n':
+			dst = append(dst, `\n`...)
+		case c == '\r':
+			dst = append(dst, `\r`...)
+		case c == '\t':
+			dst = append(dst, `\t`...)
+		case c < 0x20 || c == 0x7f:
+			dst = append(dst, `\u00`...)
+			dst = hex.AppendEncode(dst, []byte{c})
+		default:
+			dst = append(dst, c)
+		}
+	}
+	return append(dst, '"'), nil
+}
+
+// ParseCBORDiagnostic parses a data item in RFC 8949 Section 8 diagnostic notation and returns its CBOR encoding.
+// It accepts the notation produced by `AppendCBORDiagnostic`: integers of any size, floats including Infinity and NaN,
+// byte strings as h'hex', text strings with JSON escapes, arrays, maps, tags, simple values,
+// encoding indicators _0 to _3, indefinite lengths with "_" and comments enclosed in slashes.
+// Items are encoded in preferred serialization unless an encoding indicator asks otherwise,
+// integers beyond 64 bits become bignums and floats use the shortest width that represents them exactly.
+// NaN is encoded as the quiet NaN without payload.
+func ParseCBORDiagnostic(s string) ([]byte, error) {
+	p := cborDiagParser{s: s}
+	b, err := p.item(nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	if p.space(); p.pos != len(p.s) {
+		return nil, p.errorf("unexpected trailing input")
+	}
+	return b, nil
+}
+
+type cborDiagParser struct {
+	s   string
+	pos int
+	err error // unterminated comment, reported instead of the error it causes later
+}
+
+func (p *cborDiagParser) errorf(format string, args ...any) error {
+	if p.err != nil {
+		return p.err
+	}
+	return fmt.Errorf("cbor diagnostic: %s at offset %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+// space skips whitespace and comments.
+// An unterminated comment stops at its opening slash, which no token accepts, and records the error.
+func (p *cborDiagParser) space() {
+	for p.pos < len(p.s) {
+		switch c := p.s[p.pos]; {
+		case isJSONSpace(c):
+			p.pos++
+		case c == '/':
+			end := strings.IndexByte(p.s[p.pos+1:], '/')
+			if end < 0 {
+				if p.err == nil {
+					p.err = p.errorf("unterminated comment")
+				}
+				return
+			}
+			p.pos += end + 2
+		default:
+			return
+		}
+	}
+}
+
+// consume skips whitespace and reports whether the input continues with the given token, which is then consumed.
+func (p *cborDiagParser) consume(token string) bool {
+	p.space()
+	if strings.HasPrefix(p.s[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// indicator parses an optional encoding indicator _0 to _3 and returns the additional information it asks for, or 0.
+func (p *cborDiagParser) indicator() byte {
+	if p.pos+1 < len(p.s) && p.s[p.pos] == '_' && p.s[p.pos+1] >= '0' && p.s[p.pos+1] <= '3' {
+		p.pos += 2
+		return 24 + p.s[p.pos-1] - '0'
+	}
+	return 0
+}
+
+// head appends the head of a data item, using the additional information of an encoding indicator if given.
+func (p *cborDiagParser) head(dst []byte, major byte, arg uint64, additional byte) ([]byte, error) {
+	if additional == 0 {
+		return cborAppendHead(dst, major, arg), nil
+	}
+	size := 1 << (additional - 24)
+	if size < 8 && arg >= 1<<(8*size) {
+		return dst, p.errorf("argument %d does not fit encoding indicator _%d", arg, additional-24)
+	}
+	dst = append(dst, major|additional)
+	for i := size - 1; i >= 0; i-- {
+		dst = append(dst, byte(arg>>(8*i)))
+	}
+	return dst, nil
+}
+
+func (p *cborDiagParser) item(dst []byte, depth int) ([]byte, error) {
+	if depth > cborMaxDepth {
+		return dst, p.errorf("nesting exceeds %d levels", cborMaxDepth)
+	}
+	p.space()
+	if p.pos == len(p.s) {
+		return dst, p.errorf("unexpected end of input")
+	}
+	rest := p.s[p.pos:]
+	switch c := rest[0]; {
+	case c == '[':
+		p.pos++
+		return p.container(dst, CBOR_ARRAY, ']', depth)
+	case c == '{':
+		p.pos++
+		return p.container(dst, CBOR_MAP, '}', depth)
+	case c == '(':
+		p.pos++
+		if !p.consume("_") {
+			return dst, p.errorf("expected _ for indefinite length string")
+		}
+		return p.indefiniteString(dst)
+	case strings.HasPrefix(rest, "''_"):
+		p.pos += 3
+		return append(dst, CBOR_BYTESTRING|31, CBOR_BREAK), nil
+	case strings.HasPrefix(rest, `""_`) && (len(rest) == 3 || rest[3] < '0' || rest[3] > '3'): // not an encoding indicator
+		p.pos += 3
+		return append(dst, CBOR_TEXTSTRING|31, CBOR_BREAK), nil
+	case c == 'h' && strings.HasPrefix(rest, "h'"), c == '"':
+		return p.str(dst)
+	case c == '-' || c >= '0' && c <= '9' || strings.HasPrefix(rest, "Infinity") || strings.HasPrefix(rest, "NaN"):
+		return p.number(dst, depth)
+	}
+	for _, simple := range []struct {
+		name  string
+		value byte
+	}{{"false", 20}, {"true", 21}, {"null", 22}, {"undefined", 23}} {
+		if strings.HasPrefix(rest, simple.name) {
+			p.pos += len(simple.name)
+			return append(dst, CBOR_TYPE7|simple.value), nil
+		}
+	}
+	if p.consume("simple(") {
+		start := p.pos
+		for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+			p.pos++
+		}
+		v, err := strconv.ParseUint(p.s[start:p.pos], 10, 8)
+		if err != nil || v >= 24 && v < 32 {
+			return dst, p.errorf("invalid simple value %q", p.s[start:p.pos])
+		}
+		if !p.consume(")") {
+			return dst, p.errorf("expected )")
+		}
+		return cborAppendHead(dst, CBOR_TYPE7, v), nil
+	}
+	return dst, p.errorf("unexpected %q", rest[:min(len(rest), 10)])
+}
+
+// container parses the items of an array or map after the opening bracket.
+func (p *cborDiagParser) container(dst []byte, major byte, close byte, depth int) ([]byte, error) {
+	p.space()
+	indefinite := false
+	additional := p.indicator()
+	if additional == 0 && p.pos < len(p.s) && p.s[p.pos] == '_' {
+		p.pos++
+		indefinite = true
+	}
+	var items []byte
+	var count uint64
+	for !p.consume(string(close)) {
+		if count > 0 && !p.consume(",") {
+			return dst, p.errorf("expected , or %c", close)
+		}
+		var err error
+		if items, err = p.item(items, depth+1); err != nil {
+			return dst, err
+		}
+		if major == CBOR_MAP {
+			if !p.consume(":") {
+				return dst, p.errorf("expected :")
+			}
+			if items, err = p.item(items, depth+1); err != nil {
+				return dst, err
+			}
+		}
+		count++
+	}
+	if indefinite {
+		dst = append(dst, major|31)
+		dst = append(dst, items...)
+		return append(dst, CBOR_BREAK), nil
+	}
+	dst, err := p.head(dst, major, count, additional)
+	return append(dst, items...), err
+}
+
+// str parses a byte string h'hex' or a text string with an optional encoding indicator.
+func (p *cborDiagParser) str(dst []byte) ([]byte, error) {
+	var major byte
+	var content []byte
+	if p.s[p.pos] == 'h' {
+		end := strings.IndexByte(p.s[p.pos+2:], '\'')
+		if end < 0 {
+			return dst, p.errorf("unterminated byte string")
+		}
+		digits := strings.Map(func(r rune) rune {
+			if isJSONSpace(byte(r)) {
+				return -1
+			}
+			return r
+		}, p.s[p.pos+2:p.pos+2+end])
+		var err error
+		if content, err = hex.DecodeString(digits); err != nil {
+			return dst, p.errorf("invalid byte string: %v", err)
+		}
+		major = CBOR_BYTESTRING
+		p.pos += end + 3
+	} else {
+		end := p.pos + 1
+		for end < len(p.s) && p.s[end] != '"' {
+			if p.s[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(p.s) {
+			return dst, p.errorf("unterminated text string")
+		}
+		var err error
+		if content, err = jsonUnescape([]byte(p.s[p.pos+1 : end])); err != nil {
+			return dst, p.errorf("invalid text string: %v", err)
+		}
+		major = CBOR_TEXTSTRING
+		p.pos = end + 1
+	}
+	dst, err := p.head(dst, major, uint64(len(content)), p.indicator())
+	return append(dst, content...), err
+}
+
+// indefiniteString parses the chunks of an indefinite length string after "(_".
+func (p *cborDiagParser) indefiniteString(dst []byte) ([]byte, error) {
+	start := len(dst)
+	dst = append(dst, CBOR_BYTESTRING|31)
+	for count := 0; !p.consume(")"); count++ {
+		if count > 0 && !p.consume(",") {
+			return dst, p.errorf("expected , or )")
+		}
+		p.space()
+		if p.pos == len(p.s) || p.s[p.pos] != '"' && !strings.HasPrefix(p.s[p.pos:], "h'") {
+			return dst, p.errorf("expected string chunk")
+		}
+		chunk := len(dst)
+		var err error
+		if dst, err = p.str(dst); err != nil {
+			return dst, err
+		}
+		if count == 0 {
+			dst[start] = dst[chunk]&CBOR_MAJOR | 31
+		} else if dst[chunk]&CBOR_MAJOR != dst[start]&CBOR_MAJOR {
+			return dst, p.errorf("mixed byte and text string chunks")
+		}
+	}
+	return append(dst, CBOR_BREAK), nil
+}
+
+// number parses an integer, a float or a tag.
+func (p *cborDiagParser) number(dst []byte, depth int) ([]byte, error) {
+	start := p.pos
+	neg := p.s[p.pos] == '-'
+	if neg {
+		p.pos++
+	}
+	rest := p.s[p.pos:]
+	if strings.HasPrefix(rest, "Infinity") || strings.HasPrefix(rest, "NaN") {
+		if strings.HasPrefix(rest, "NaN") {
+			if neg {
+				return dst, p.errorf("invalid number -NaN")
+			}
+			p.pos += 3
+			return p.float(dst, math.NaN())
+		}
+		p.pos += len("Infinity")
+		sign := 1
+		if neg {
+			sign = -1
+		}
+		return p.float(dst, math.Inf(sign))
+	}
+	isFloat := false
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if c == '.' || c == 'e' || c == 'E' || (c == '+' || c == '-') && (p.s[p.pos-1] == 'e' || p.s[p.pos-1] == 'E') {
+			isFloat = true
+		} else if c < '0' || c > '9' {
+			break
+		}
+		p.pos++
+	}
+	token := p.s[start:p.pos]
+	if isFloat {
+		f, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return dst, p.errorf("invalid number %q", token)
+		}
+		return p.float(dst, f)
+	}
+
+	digits := strings.TrimPrefix(token, "-")
+	v, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		if digits == "" || !errors.Is(err, strconv.ErrRange) {
+			return dst, p.errorf("invalid number %q", token)
+		}
+		return p.bignum(dst, token)
+	}
+	additional := p.indicator()
+	if !neg && p.pos < len(p.s) && p.s[p.pos] == '(' {
+		p.pos++
+		if dst, err = p.head(dst, CBOR_TAG, v, additional); err != nil {
+			return dst, err
+		}
+		if dst, err = p.item(dst, depth+1); err != nil {
+			return dst, err
+		}
+		if !p.consume(")") {
+			return dst, p.errorf("expected ) after tag content")
+		}
+		return dst, nil
+	}
+	if !neg {
+		return p.head(dst, CBOR_INTPOS, v, additional)
+	}
+	if v == 0 {
+		return p.head(dst, CBOR_INTPOS, 0, additional)
+	}
+	return p.head(dst, CBOR_INTNEG, v-1, additional)
+}
+
+// bignum encodes an integer beyond 64 bits as an integer of major type 1 if possible and as a bignum tag otherwise.
+func (p *cborDiagParser) bignum(dst []byte, token string) ([]byte, error) {
+	v, ok := new(big.Int).SetString(token, 10)
+	if !ok {
+		return dst, p.errorf("invalid number %q", token)
+	}
+	tag := byte(CBOR_TAG_BIGNUMPOS)
+	if v.Sign() < 0 {
+		v.Not(v) // -1 - v
+		if v.IsUint64() {
+			return cborAppendHead(dst, CBOR_INTNEG, v.Uint64()), nil
+		}
+		tag = CBOR_TAG_BIGNUMNEG
+	}
+	content := v.Bytes()
+	dst = append(dst, tag)
+	dst = cborAppendHead(dst, CBOR_BYTESTRING, uint64(len(content)))
+	return append(dst, content...), nil
+}
+
+// float encodes a float with the width given by an encoding indicator or the shortest width that represents it exactly.
+func (p *cborDiagParser) float(dst []byte, f float64) ([]byte, error) {
+	additional := p.indicator()
+	exact32 := float64(float32(f)) == f || math.IsNaN(f)
+	switch {
+	case additional == 25 || additional == 0 && exact32 && cborFloat16Exact(float32(f)):
+		if !exact32 || !cborFloat16Exact(float32(f)) {
+			return dst, p.errorf("float %g cannot be represented exactly as float16", f)
+		}
+		bits := float16.Fromfloat32(float32(f)).Bits()
+		if math.IsNaN(f) {
+			bits = 0x7e00 // quiet NaN without payload, as in RFC 8949 Section 4.2.2
+		}
+		return append(dst, CBOR_FLOAT16, byte(bits>>8), byte(bits)), nil
+	case additional == 26 || additional == 0 && exact32:
+		if !exact32 {
+			return dst, p.errorf("float %g cannot be represented exactly as float32", f)
+		}
+		bits := math.Float32bits(float32(f))
+		if math.IsNaN(f) {
+			bits = 0x7fc00000
+		}
+		return p.head(dst, CBOR_TYPE7, uint64(bits), 26)
+	case additional == 27 || additional == 0:
+		bits := math.Float64bits(f)
+		if math.IsNaN(f) {
+			bits = 0x7ff8000000000000
+		}
+		return p.head(dst, CBOR_TYPE7, bits, 27)
+	default:
+		return dst, p.errorf("invalid encoding indicator for float")
+	}
+}
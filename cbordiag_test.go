@@ -0,0 +1,257 @@
+package decimal_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestDecimal_CBORDiagnostic(t *testing.T) {
+	tests := []struct {
+		name string
+		d    decimal.Decimal
+		want string
+	}{
+		{"zero", decimal.Decimal{}, "0"},
+		{"integer", decimal.Decimal{Integer: 1250}, "1250"},
+		{"negative_integer", decimal.Decimal{Negative: true, Integer: 7}, "-7"},
+		{"fraction", decimal.Decimal{Integer: 123, Fraction: 45, Digits: 2}, "4([-2, 12345])"},
+		{"trailing_zero", decimal.Decimal{Integer: 1, Fraction: 0, Digits: 1}, "4([-1, 10])"},
+		{"negative_fraction", decimal.Decimal{Negative: true, Fraction: 5, Digits: 1}, "4([-1, -5])"},
+		{"bignum", decimal.Decimal{Integer: 1234567890123456789, Fraction: 1, Digits: 19}, "4([-19, 2(h'0949b0f6f0023313c292f50538080001')])"},
+		{"negative_bignum", decimal.Decimal{Negative: true, Integer: 1234567890123456789, Fraction: 1, Digits: 19}, "4([-19, 3(h'0949b0f6f0023313c292f50538080000')])"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.CBORDiagnostic(); got != tt.want {
+				t.Errorf("CBORDiagnostic() = %q, want %q", got, tt.want)
+			}
+			back, err := decimal.NewFromCBORDiagnostic(tt.want)
+			if err != nil || back != tt.d {
+				t.Errorf("NewFromCBORDiagnostic(%q) = %#v, %v, want %#v", tt.want, back, err, tt.d)
+			}
+		})
+	}
+}
+
+func TestFixed_CBORDiagnostic(t *testing.T) {
+	if got, want := decimal.Fixed(-1250).CBORDiagnostic(), "4([-2, -1250])"; got != want {
+		t.Errorf("CBORDiagnostic() = %q, want %q", got, want)
+	}
+}
+
+func TestAppendCBORDiagnostic(t *testing.T) {
+	tests := []struct {
+		hex     string
+		want    string
+		wantErr bool
+	}{
+		{"1817", "23_0", false},
+		{"190001", "1_1", false},
+		{"3a00000000", "-1_2", false},
+		{"3bffffffffffffffff", "-18446744073709551616", false},
+		{"c49f21193039ff", "4([_ -2, 12345])", false},
+		{"c4980221193039", "4([_0 -2, 12345])", false},
+		{"d80401", "4_0(1)", false},
+		{"4101", "h'01'", false},
+		{"5f4101420203ff", "(_ h'01', h'0203')", false},
+		{"7f6161ff", `(_ "a")`, false},
+		{"5fff", "''_", false},
+		{"7fff", `""_`, false},
+		{"80", "[]", false},
+		{"6461220a5c", `"a\"\n\\"`, false},
+		{"6101", `"\u0001"`, false},
+		{"a201020304", "{1: 2, 3: 4}", false},
+		{"bf6161f5ff", `{_ "a": true}`, false},
+		{"f820", "simple(32)", false},
+		{"f0", "simple(16)", false},
+		{"f97c00", "Infinity", false},
+		{"f98000", "-0.0", false},
+		{"fa3fc00000", "1.5_2", false},
+		{"fa3dcccccd", "0.10000000149011612", false},
+		{"fb3fb999999999999a", "0.1", false},
+		{"fb4415af1d78b58c40", "100000000000000000000.0", false},
+		{"fa7f7fffff", "3.4028234663852886e+38", false},
+		{"fb444b1ae4d6e2ef50", "1.0e+21", false},
+		{"", "", true},
+		{"18", "", true},
+		{"f810", "", true},
+		{"ff", "", true},
+		{"1c", "", true},
+		{"6280", "", true},
+		{"4201", "", true},
+		{"82", "", true},
+		{"9f01", "", true},
+		{"5f6161ff", "", true},
+		{"5f5f4101ffff", "", true},
+		{strings.Repeat("81", 300) + "00", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.hex, func(t *testing.T) {
+			data, _ := hex.DecodeString(tt.hex)
+			got, n, err := decimal.AppendCBORDiagnostic(nil, data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AppendCBORDiagnostic(%s) error = %v, wantErr %v", tt.hex, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(got) != tt.want || n != len(data) {
+				t.Errorf("AppendCBORDiagnostic(%s) = %q, %d, want %q, %d", tt.hex, got, n, tt.want, len(data))
+			}
+			back, err := decimal.ParseCBORDiagnostic(tt.want)
+			if err != nil || !bytes.Equal(back, data) {
+				t.Errorf("ParseCBORDiagnostic(%q) = %x, %v, want %s", tt.want, back, err, tt.hex)
+			}
+		})
+	}
+}
+
+func TestAppendCBORDiagnostic_Sequence(t *testing.T) {
+	data := cborHex("01c48221196ab3f5")
+	var got []string
+	for len(data) > 0 {
+		s, n, err := decimal.AppendCBORDiagnostic(nil, data)
+		if err != nil {
+			t.Fatalf("AppendCBORDiagnostic(%x) error = %v", data, err)
+		}
+		got, data = append(got, string(s)), data[n:]
+	}
+	if want := []string{"1", "4([-2, 27315])", "true"}; strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("AppendCBORDiagnostic() = %q, want %q", got, want)
+	}
+}
+
+func TestParseCBORDiagnostic(t *testing.T) {
+	tests := []struct {
+		diag    string
+		want    string
+		wantErr bool
+	}{
+		{" 4( [ -2 , 12345 ] ) ", "c48221193039", false},
+		{"4([-2, 12345]) / 123.45 /", "c48221193039", false},
+		{"/ price / 4([-2, /mantissa/ 12345])", "c48221193039", false},
+		{"18446744073709551615", "1bffffffffffffffff", false},
+		{"18446744073709551616", "c249010000000000000000", false},
+		{"-18446744073709551616", "3bffffffffffffffff", false},
+		{"-18446744073709551617", "c349010000000000000000", false},
+		{"4([-20, 123456789012345678901234567890])", "c48233c24d018ee90ff6c373e0ee4e3f0ad2", false},
+		{"-0", "00", false},
+		{"1.5", "f93e00", false},
+		{"1.5_3", "fb3ff8000000000000", false},
+		{"1e5", "fa47c35000", false},
+		{"0.1", "fb3fb999999999999a", false},
+		{"-Infinity", "f9fc00", false},
+		{"NaN_2", "fa7fc00000", false},
+		{"h'01 02'", "420102", false},
+		{`"ü"`, "62c3bc", false},
+		{"[]", "80", false},
+		{"{}", "a0", false},
+		{"[_ ]", "9fff", false},
+		{`[""_, ""_0]`, "827fff7800", false},
+		{"[1, [2, 3]]", "8201820203", false},
+		{"undefined", "f7", false},
+		{"simple(255)", "f8ff", false},
+		{"", "", true},
+		{"4(", "", true},
+		{"4([-2, 1]", "", true},
+		{"4([-2, 1]) / 0.01", "", true},
+		{"[1 2]", "", true},
+		{"{1}", "", true},
+		{"1 2", "", true},
+		{"-", "", true},
+		{"1.2.3", "", true},
+		{"-NaN", "", true},
+		{"0.1_2", "", true},
+		{"1.5_0", "", true},
+		{"256_0", "", true},
+		{"h'0'", "", true},
+		{"h'01", "", true},
+		{`"abc`, "", true},
+		{`"\x"`, "", true},
+		{"(_ h'01', \"a\")", "", true},
+		{"(_ 1)", "", true},
+		{"(h'01')", "", true},
+		{"simple(24)", "", true},
+		{"simple(256)", "", true},
+		{"nil", "", true},
+		{strings.Repeat("[", 300) + strings.Repeat("]", 300), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.diag, func(t *testing.T) {
+			got, err := decimal.ParseCBORDiagnostic(tt.diag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCBORDiagnostic(%q) error = %v, wantErr %v", tt.diag, err, tt.wantErr)
+			}
+			if !tt.wantErr && hex.EncodeToString(got) != tt.want {
+				t.Errorf("ParseCBORDiagnostic(%q) = %x, want %s", tt.diag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromCBORDiagnostic_Error(t *testing.T) {
+	for _, s := range []string{"4([-2", `"1.5"`, "Infinity", "4([-2, 1]) / comment"} {
+		if d, err := decimal.NewFromCBORDiagnostic(s); err == nil {
+			t.Errorf("NewFromCBORDiagnostic(%q) = %v, want error", s, d)
+		}
+	}
+	for _, s := range []string{"4([-2, 1]) / comment", "4([-2, / comment 1])", "/ comment"} {
+		if _, err := decimal.NewFromCBORDiagnostic(s); err == nil || !strings.Contains(err.Error(), "unterminated comment") {
+			t.Errorf("NewFromCBORDiagnostic(%q) error = %v, want unterminated comment", s, err)
+		}
+	}
+}
+
+// TestCBORDiagnostic_RFC8949 checks the diagnostic notation of the RFC 8949 Appendix A examples.
+// Every encoding must survive a round trip through its diagnostic notation, and parsing the notation given by the RFC
+// must reproduce the encoding unless the example uses an encoding that the notation does not capture without indicators.
+func TestCBORDiagnostic_RFC8949(t *testing.T) {
+	data, err := os.ReadFile("testdata/rfc8949-appendix-a.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		encoded, diag := mustCBORHex(t, fields[0]), fields[2]
+		t.Run(fields[0], func(t *testing.T) {
+			got, n, err := decimal.AppendCBORDiagnostic(nil, encoded)
+			if err != nil || n != len(encoded) {
+				t.Fatalf("AppendCBORDiagnostic(%x) = %q, %d, %v", encoded, got, n, err)
+			}
+			back, err := decimal.ParseCBORDiagnostic(string(got))
+			if err != nil || !bytes.Equal(back, encoded) {
+				t.Errorf("ParseCBORDiagnostic(%q) = %x, %v, want %x", got, back, err, encoded)
+			}
+			if strings.HasPrefix(string(got), diag+"_") {
+				return // the RFC omits the encoding indicator of floats that are wider than necessary
+			}
+			if string(got) != diag && !strings.HasPrefix(string(got), "2(") && !strings.HasPrefix(string(got), "3(") {
+				t.Errorf("AppendCBORDiagnostic(%x) = %q, want %q", encoded, got, diag)
+			}
+			if back, err := decimal.ParseCBORDiagnostic(diag); err != nil || !bytes.Equal(back, encoded) {
+				t.Errorf("ParseCBORDiagnostic(%q) = %x, %v, want %x", diag, back, err, encoded)
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_CBORDiagnostic(b *testing.B) {
+	d := decimal.Decimal{Integer: 123, Fraction: 45, Digits: 2}
+	for b.Loop() {
+		_ = d.CBORDiagnostic()
+	}
+}
+
+func BenchmarkParseCBORDiagnostic(b *testing.B) {
+	for b.Loop() {
+		_, _ = decimal.ParseCBORDiagnostic("4([-2, 12345])")
+	}
+}
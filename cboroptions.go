@@ -0,0 +1,56 @@
+package decimal
+
+import "io"
+
+// CBOROptions configures alternative CBOR encodings of decimal and fixed-point values.
+// The zero value produces the same output as `MarshalCBOR`: preferred serialization that keeps the number of fractional digits,
+// so 1.0 and 1 encode differently.
+type CBOROptions struct {
+	// Deterministic applies RFC 8949 Section 4.2.1 core deterministic encoding with scale-normalized mantissas:
+	// trailing zeros are removed before encoding, so equal values always produce identical bytes.
+	// Integers such as 1.0 are encoded as CBOR integers, other values as decimal fractions with the shortest mantissa,
+	// e.g. 12.50 as 4([-1, 125]). Negative zero is encoded as zero.
+	Deterministic bool
+}
+
+// AppendDecimal appends the CBOR encoding of the decimal value according to the options to b and returns the extended buffer.
+func (o CBOROptions) AppendDecimal(b []byte, d Decimal) []byte {
+	if o.Deterministic {
+		d = d.Canonical()
+	}
+	b, _ = d.AppendCBOR(b) // cannot fail
+	return b
+}
+
+// AppendFixed appends the CBOR encoding of the fixed-point value according to the options to b and returns the extended buffer.
+func (o CBOROptions) AppendFixed(b []byte, f Fixed) []byte {
+	return o.AppendDecimal(b, f.Decimal())
+}
+
+// CBOREncoder writes decimal and fixed-point values as a CBOR sequence (RFC 8742) to an io.Writer.
+// Each value is encoded into a reused buffer and written with a single call to Write, so encoding does not allocate.
+// Wrap the writer in a `bufio.Writer` when encoding many values.
+type CBOREncoder struct {
+	w    io.Writer
+	opts CBOROptions
+	buf  []byte
+}
+
+// NewCBOREncoder returns an encoder that writes to w using the given options.
+func NewCBOREncoder(w io.Writer, opts CBOROptions) *CBOREncoder {
+	return &CBOREncoder{w: w, opts: opts, buf: make([]byte, 0, 32)}
+}
+
+// Encode writes the CBOR encoding of the decimal value to the stream.
+func (e *CBOREncoder) Encode(d Decimal) error {
+	e.buf = e.opts.AppendDecimal(e.buf[:0], d)
+	_, err := e.w.Write(e.buf)
+	return err
+}
+
+// EncodeFixed writes the CBOR encoding of the fixed-point value to the stream.
+func (e *CBOREncoder) EncodeFixed(f Fixed) error {
+	e.buf = e.opts.AppendFixed(e.buf[:0], f)
+	_, err := e.w.Write(e.buf)
+	return err
+}
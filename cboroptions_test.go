@@ -0,0 +1,133 @@
+package decimal_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestCBOROptions_AppendDecimal(t *testing.T) {
+	deterministic := decimal.CBOROptions{Deterministic: true}
+	tests := []struct {
+		name string
+		opts decimal.CBOROptions
+		d    decimal.Decimal
+		want string
+	}{
+		{"default_integer", decimal.CBOROptions{}, decimal.Decimal{Integer: 1}, "01"},
+		{"default_scaled_integer", decimal.CBOROptions{}, decimal.Decimal{Integer: 1, Digits: 1}, "c482200a"},
+		{"default_trailing_zeros", decimal.CBOROptions{}, decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}, "c482211904e2"},
+		{"integer", deterministic, decimal.Decimal{Integer: 1}, "01"},
+		{"scaled_integer", deterministic, decimal.Decimal{Integer: 1, Digits: 1}, "01"},
+		{"negative_scaled_integer", deterministic, decimal.Decimal{Negative: true, Integer: 24, Digits: 3}, "37"},
+		{"trailing_zeros", deterministic, decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}, "c48220187d"},
+		{"negative", deterministic, decimal.Decimal{Negative: true, Integer: 12, Fraction: 500, Digits: 3}, "c48220387c"},
+		{"negative_zero", deterministic, decimal.Decimal{Negative: true, Digits: 5}, "00"},
+		{"zero_with_digits", deterministic, decimal.Decimal{Digits: 5}, "00"},
+		{"bignum", deterministic, decimal.Decimal{Integer: 123, Fraction: 1234567890123456789, Digits: 19}, "c48232c24942becfe422c0618115"},
+		{"bignum_normalized", deterministic, decimal.Decimal{Integer: 18446744073709551615, Fraction: 50, Digits: 2}, "c48220c24909fffffffffffffffb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.opts.AppendDecimal([]byte{0x82}, tt.d)
+			if hex.EncodeToString(got) != "82"+tt.want {
+				t.Errorf("AppendDecimal() = %x, want 82%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCBOROptions_Deterministic(t *testing.T) {
+	opts := decimal.CBOROptions{Deterministic: true}
+	groups := [][]decimal.Decimal{
+		{{Integer: 1}, {Integer: 1, Digits: 1}, {Integer: 1, Digits: 19}},
+		{{Fraction: 5, Digits: 1}, {Fraction: 50, Digits: 2}, {Fraction: 5000000000000000000, Digits: 19}},
+		{{}, {Digits: 3}, {Negative: true}, {Negative: true, Digits: 19}},
+	}
+	for _, group := range groups {
+		want := opts.AppendDecimal(nil, group[0])
+		for _, d := range group[1:] {
+			if got := opts.AppendDecimal(nil, d); !bytes.Equal(got, want) {
+				t.Errorf("AppendDecimal(%#v) = %x, want %x", d, got, want)
+			}
+		}
+		var back decimal.Decimal
+		if err := back.UnmarshalCBOR(want); err != nil || back != group[0].Canonical() {
+			t.Errorf("UnmarshalCBOR(%x) = %#v, %v, want %#v", want, back, err, group[0].Canonical())
+		}
+	}
+}
+
+func TestCBOROptions_AppendFixed(t *testing.T) {
+	tests := []struct {
+		opts decimal.CBOROptions
+		f    decimal.Fixed
+		want string
+	}{
+		{decimal.CBOROptions{}, 100, "c482211864"},
+		{decimal.CBOROptions{Deterministic: true}, 100, "01"},
+		{decimal.CBOROptions{Deterministic: true}, 1250, "c48220187d"},
+		{decimal.CBOROptions{Deterministic: true}, -1, "c4822120"},
+	}
+	for _, tt := range tests {
+		if got := tt.opts.AppendFixed(nil, tt.f); hex.EncodeToString(got) != tt.want {
+			t.Errorf("%+v.AppendFixed(%d) = %x, want %s", tt.opts, int32(tt.f), got, tt.want)
+		}
+	}
+}
+
+func TestCBOREncoder(t *testing.T) {
+	var buf bytes.Buffer
+	enc := decimal.NewCBOREncoder(&buf, decimal.CBOROptions{Deterministic: true})
+	for _, d := range []decimal.Decimal{{Integer: 1, Digits: 2}, {Integer: 12, Fraction: 50, Digits: 2}, {Negative: true, Integer: 24}} {
+		if err := enc.Encode(d); err != nil {
+			t.Fatalf("Encode(%v) error = %v", d, err)
+		}
+	}
+	if err := enc.EncodeFixed(1250); err != nil {
+		t.Fatalf("EncodeFixed() error = %v", err)
+	}
+	if got, want := hex.EncodeToString(buf.Bytes()), "01"+"c48220187d"+"37"+"c48220187d"; got != want {
+		t.Errorf("CBOREncoder wrote %s, want %s", got, want)
+	}
+
+	buf.Reset()
+	enc = decimal.NewCBOREncoder(&buf, decimal.CBOROptions{})
+	if err := enc.Encode(decimal.Decimal{Integer: 1, Digits: 1}); err != nil || hex.EncodeToString(buf.Bytes()) != "c482200a" {
+		t.Errorf("CBOREncoder wrote %x, %v, want c482200a", buf.Bytes(), err)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestCBOREncoder_Error(t *testing.T) {
+	enc := decimal.NewCBOREncoder(failingWriter{}, decimal.CBOROptions{})
+	if err := enc.Encode(decimal.Decimal{Integer: 1}); err == nil {
+		t.Errorf("Encode() error = nil, want write error")
+	}
+	if err := enc.EncodeFixed(1); err == nil {
+		t.Errorf("EncodeFixed() error = nil, want write error")
+	}
+}
+
+func TestCBOREncoder_Allocs(t *testing.T) {
+	enc := decimal.NewCBOREncoder(io.Discard, decimal.CBOROptions{Deterministic: true})
+	d := decimal.Decimal{Integer: 123, Fraction: 1234567890123456789, Digits: 19}
+	if allocs := testing.AllocsPerRun(100, func() { _ = enc.Encode(d); _ = enc.EncodeFixed(12345) }); allocs != 0 {
+		t.Errorf("CBOREncoder allocates %v times per value", allocs)
+	}
+}
+
+func BenchmarkCBOREncoder_Encode(b *testing.B) {
+	enc := decimal.NewCBOREncoder(io.Discard, decimal.CBOROptions{Deterministic: true})
+	d := decimal.Decimal{Integer: 123, Fraction: 4500, Digits: 4}
+	for b.Loop() {
+		_ = enc.Encode(d)
+	}
+}
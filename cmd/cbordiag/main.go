@@ -0,0 +1,112 @@
+// Command cbordiag prints CBOR data in RFC 8949 diagnostic notation.
+//
+// The input is read from the named files, or from standard input if there are none, and treated as a CBOR sequence (RFC 8742).
+// Each data item is printed on its own line. Items that decode as a decimal value or as an array of decimal values
+// are followed by a comment with the value, e.g. 4([-2, 12345]) / 123.45 /.
+// With -hex, the input is hexadecimal text instead of binary CBOR; whitespace is ignored.
+//
+// Usage from the repository root:
+//
+//	go run ./cmd/cbordiag [-hex] [file ...]
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func main() {
+	hexInput := flag.Bool("hex", false, "read the input as hexadecimal text")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("cbordiag: ")
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	if flag.NArg() == 0 {
+		if err := run(w, os.Stdin, *hexInput); err != nil {
+			w.Flush()
+			log.Fatal(err)
+		}
+		return
+	}
+	for _, name := range flag.Args() {
+		f, err := os.Open(name)
+		if err != nil {
+			w.Flush()
+			log.Fatal(err)
+		}
+		err = run(w, f, *hexInput)
+		f.Close()
+		if err != nil {
+			w.Flush()
+			log.Fatalf("%s: %v", name, err)
+		}
+	}
+}
+
+// run reads a CBOR sequence from r and writes the diagnostic notation of its items to w.
+func run(w io.Writer, r io.Reader, hexInput bool) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if hexInput {
+		if data, err = hex.DecodeString(strings.Join(strings.Fields(string(data)), "")); err != nil {
+			return fmt.Errorf("invalid hex input: %w", err)
+		}
+	}
+	return printSequence(w, data)
+}
+
+// printSequence writes one line of diagnostic notation for every data item in the CBOR sequence.
+func printSequence(w io.Writer, data []byte) error {
+	var line []byte
+	for offset := 0; offset < len(data); {
+		var n int
+		var err error
+		line, n, err = decimal.AppendCBORDiagnostic(line[:0], data[offset:])
+		if err != nil {
+			return fmt.Errorf("item at offset %d: %w", offset, err)
+		}
+		line = annotate(line, data[offset:offset+n])
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+		offset += n
+	}
+	return nil
+}
+
+// annotate appends a comment with the decimal value of the item unless the diagnostic notation already shows it.
+func annotate(line, item []byte) []byte {
+	var d decimal.Decimal
+	if d.UnmarshalCBOR(item) == nil {
+		if s := d.String(); s != string(line) {
+			line = append(line, " / "...)
+			line = append(line, s...)
+			line = append(line, " /"...)
+		}
+		return line
+	}
+	values, err := decimal.DecodeCBORDecimalArray(item)
+	if err != nil {
+		return line
+	}
+	line = append(line, " / ["...)
+	for i, v := range values {
+		if i > 0 {
+			line = append(line, ", "...)
+		}
+		line = append(line, v.String()...)
+	}
+	return append(line, "] /"...)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"integer", "1904e2", "1250\n", false},
+		{"decimal", "c48221193039", "4([-2, 12345]) / 123.45 /\n", false},
+		{"float", "f93e00", "1.5\n", false},
+		{"sequence", "c4 82 21 19 6a b3\n01\nf5", "4([-2, 27315]) / 273.15 /\n1\ntrue\n", false},
+		{"typed_array", "c48221d849443039046a", "4([-2, 73(h'3039046a')]) / [123.45, 11.30] /\n", false},
+		{"array", "82c4822119303901", "[4([-2, 12345]), 1] / [123.45, 1] /\n", false},
+		{"other", "a1616101", "{\"a\": 1}\n", false},
+		{"invalid_hex", "c4z", "", true},
+		{"truncated", "01c482", "1\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			err := run(&out, strings.NewReader(tt.input), true)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("run(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if out.String() != tt.want {
+				t.Errorf("run(%q) = %q, want %q", tt.input, out.String(), tt.want)
+			}
+		})
+	}
+}
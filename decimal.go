@@ -188,6 +188,67 @@ func (d Decimal) Round(digits uint8) Decimal {
 	return d
 }
 
+// RoundingMode selects how values are rounded when digits are removed.
+type RoundingMode uint8
+
+const (
+	RoundHalfUp   RoundingMode = iota // Round to nearest, ties away from zero
+	RoundHalfEven                     // Round to nearest, ties to even
+	RoundHalfDown                     // Round to nearest, ties toward zero
+	RoundDown                         // Round toward zero (truncate)
+	RoundUp                           // Round away from zero
+	RoundCeiling                      // Round toward positive infinity
+	RoundFloor                        // Round toward negative infinity
+)
+
+// RoundMode rounds a decimal value to the specified number of digits after the decimal point using the given rounding mode.
+// `RoundHalfUp` matches `Round` while `RoundDown` matches `ToDigits`.
+// The number of digits is limited to 19.
+func (d Decimal) RoundMode(digits uint8, mode RoundingMode) Decimal {
+	if digits > 19 {
+		digits = 19
+	}
+	if digits >= d.Digits {
+		return d.ToDigits(digits)
+	}
+	div := pow10[d.Digits-digits]
+	quo, rem := d.Fraction/div, d.Fraction%div
+	half := div / 2
+	up := false
+	switch mode {
+	case RoundHalfEven:
+		last := quo
+		if digits == 0 {
+			last = d.Integer
+		}
+		up = rem > half || (rem == half && last&1 == 1)
+	case RoundHalfDown:
+		up = rem > half
+	case RoundDown:
+	case RoundUp:
+		up = rem != 0
+	case RoundCeiling:
+		up = rem != 0 && !d.Negative
+	case RoundFloor:
+		up = rem != 0 && d.Negative
+	default:
+		up = rem >= half
+	}
+	d.Fraction = quo
+	d.Digits = digits
+	if up {
+		d.Fraction++
+		if d.Fraction == pow10[digits] {
+			d.Fraction = 0
+			d.Integer++
+		}
+	}
+	if d.Integer == 0 && d.Fraction == 0 {
+		d.Negative = false
+	}
+	return d
+}
+
 // Truncate removes trailing zeros from the decimal value.
 func (d Decimal) Truncate() Decimal {
 	if d.Fraction == 0 {
@@ -221,3 +282,9 @@ func (d Decimal) Truncate() Decimal {
 
 	return d
 }
+
+// Canonical returns the canonical representation of the decimal value with trailing zeros removed and zero without sign.
+// Values that are `Equal` have identical canonical representations, so they can be compared with ==.
+func (d Decimal) Canonical() Decimal {
+	return d.Truncate()
+}
@@ -257,6 +257,52 @@ func BenchmarkDecimal_Round(b *testing.B) {
 	}
 }
 
+func TestDecimal_RoundMode(t *testing.T) {
+	pos := decimal.Decimal{Integer: 1, Fraction: 25, Digits: 2}
+	neg := decimal.Decimal{Negative: true, Integer: 1, Fraction: 25, Digits: 2}
+	odd := decimal.Decimal{Integer: 1, Fraction: 35, Digits: 2}
+	tests := []struct {
+		name   string
+		d      decimal.Decimal
+		digits uint8
+		mode   decimal.RoundingMode
+		want   decimal.Decimal
+	}{
+		{"half_up", pos, 1, decimal.RoundHalfUp, decimal.Decimal{Integer: 1, Fraction: 3, Digits: 1}},
+		{"half_up_negative", neg, 1, decimal.RoundHalfUp, decimal.Decimal{Negative: true, Integer: 1, Fraction: 3, Digits: 1}},
+		{"half_even_down", pos, 1, decimal.RoundHalfEven, decimal.Decimal{Integer: 1, Fraction: 2, Digits: 1}},
+		{"half_even_up", odd, 1, decimal.RoundHalfEven, decimal.Decimal{Integer: 1, Fraction: 4, Digits: 1}},
+		{"half_even_integer", decimal.Decimal{Integer: 3, Fraction: 5, Digits: 1}, 0, decimal.RoundHalfEven, decimal.Decimal{Integer: 4}},
+		{"half_even_integer_even", decimal.Decimal{Integer: 2, Fraction: 5, Digits: 1}, 0, decimal.RoundHalfEven, decimal.Decimal{Integer: 2}},
+		{"half_down", pos, 1, decimal.RoundHalfDown, decimal.Decimal{Integer: 1, Fraction: 2, Digits: 1}},
+		{"half_down_above", decimal.Decimal{Integer: 1, Fraction: 251, Digits: 3}, 1, decimal.RoundHalfDown, decimal.Decimal{Integer: 1, Fraction: 3, Digits: 1}},
+		{"down", neg, 1, decimal.RoundDown, decimal.Decimal{Negative: true, Integer: 1, Fraction: 2, Digits: 1}},
+		{"up", decimal.Decimal{Integer: 1, Fraction: 21, Digits: 2}, 1, decimal.RoundUp, decimal.Decimal{Integer: 1, Fraction: 3, Digits: 1}},
+		{"ceiling_positive", decimal.Decimal{Integer: 1, Fraction: 21, Digits: 2}, 1, decimal.RoundCeiling, decimal.Decimal{Integer: 1, Fraction: 3, Digits: 1}},
+		{"ceiling_negative", decimal.Decimal{Negative: true, Integer: 1, Fraction: 29, Digits: 2}, 1, decimal.RoundCeiling, decimal.Decimal{Negative: true, Integer: 1, Fraction: 2, Digits: 1}},
+		{"floor_positive", decimal.Decimal{Integer: 1, Fraction: 29, Digits: 2}, 1, decimal.RoundFloor, decimal.Decimal{Integer: 1, Fraction: 2, Digits: 1}},
+		{"floor_negative", decimal.Decimal{Negative: true, Integer: 1, Fraction: 21, Digits: 2}, 1, decimal.RoundFloor, decimal.Decimal{Negative: true, Integer: 1, Fraction: 3, Digits: 1}},
+		{"carry", decimal.Decimal{Integer: 9, Fraction: 995, Digits: 3}, 2, decimal.RoundHalfUp, decimal.Decimal{Integer: 10, Digits: 2}},
+		{"negative_to_zero", decimal.Decimal{Negative: true, Fraction: 4, Digits: 1}, 0, decimal.RoundHalfUp, decimal.Decimal{}},
+		{"extend", pos, 4, decimal.RoundDown, decimal.Decimal{Integer: 1, Fraction: 2500, Digits: 4}},
+		{"clamp_out_of_range", pos, 48, decimal.RoundUp, decimal.Decimal{Integer: 1, Fraction: 2500000000000000000, Digits: 19}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.RoundMode(tt.digits, tt.mode); got != tt.want {
+				t.Errorf("Decimal.RoundMode() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_RoundMode(b *testing.B) {
+	d := decimal.Decimal{Integer: 1, Fraction: 2345, Digits: 4}
+	for b.Loop() {
+		_ = d.RoundMode(2, decimal.RoundHalfEven)
+	}
+}
+
 func TestDecimal_Zero(t *testing.T) {
 	zero := decimal.Decimal{}
 	if !decimal.Equal(zero, decimal.Zero()) {
@@ -1,6 +1,7 @@
 package decimal
 
 import (
+	"fmt"
 	"math"
 )
 
@@ -81,3 +82,35 @@ func (f Fixed) Decimal() Decimal {
 	d.Integer = uint64(val / 100)
 	return d
 }
+
+// fixedFromDecimal converts a decimal value to fixed-point exactly.
+// It rejects values with more than two significant fractional digits or outside the fixed-point range.
+func fixedFromDecimal(d Decimal) (Fixed, error) {
+	d = d.Truncate()
+	var frac uint64
+	switch d.Digits {
+	case 0:
+	case 1:
+		frac = d.Fraction * 10
+	case 2:
+		frac = d.Fraction
+	default:
+		return 0, fmt.Errorf("too many decimal digits: %v", d)
+	}
+	if d.Integer > math.MaxUint32 {
+		return 0, fmt.Errorf("value exceeds fixed-point range")
+	}
+
+	val := int64(d.Integer*100 + frac)
+	if d.Negative {
+		val = -val
+		if val < math.MinInt32 {
+			return 0, fmt.Errorf("value exceeds fixed-point range")
+		}
+		return Fixed(val), nil
+	}
+	if val > math.MaxInt32 {
+		return 0, fmt.Errorf("value exceeds fixed-point range")
+	}
+	return Fixed(val), nil
+}
@@ -0,0 +1,136 @@
+package decimal
+
+// SignStyle selects how the sign of a value is displayed by a `Formatter`.
+type SignStyle uint8
+
+const (
+	SignStandard   SignStyle = iota // Minus sign for negative values only: -1,234.56
+	SignAlways                      // Plus sign for zero and positive values, minus sign for negative values: +1,234.56
+	SignAccounting                  // Parentheses around negative values: (1,234.56)
+)
+
+// SymbolPlacement selects where a `Formatter` places the currency symbol.
+type SymbolPlacement uint8
+
+const (
+	SymbolBefore      SymbolPlacement = iota // Symbol directly before the number: $1,234.56
+	SymbolBeforeSpace                        // Symbol and a no-break space before the number: CHF 1'234.56
+	SymbolAfter                              // Symbol directly after the number: 1.234,56€
+	SymbolAfterSpace                         // A no-break space and symbol after the number: 1.234,56 €
+)
+
+// Formatter formats decimal values according to a locale.
+// The zero value formats values rounded to integers without grouping.
+type Formatter struct {
+	// Locale provides the decimal separator, grouping and minus sign.
+	Locale Locale
+	// MinFraction is the minimum number of fractional digits, missing digits are filled with zeros.
+	MinFraction uint8
+	// MaxFraction is the maximum number of fractional digits, excess digits are rounded using Rounding.
+	// Trailing zeros beyond MinFraction are removed. Values lower than MinFraction are raised to MinFraction.
+	MaxFraction uint8
+	// Rounding is the rounding mode used to remove excess fractional digits.
+	Rounding RoundingMode
+	// Sign selects how the sign is displayed.
+	Sign SignStyle
+	// Symbol is a currency symbol such as "$" or "€". An empty string omits the symbol.
+	Symbol string
+	// SymbolPlacement selects where the symbol is placed.
+	SymbolPlacement SymbolPlacement
+}
+
+// Format formats a decimal value as a string.
+func (f Formatter) Format(d Decimal) string {
+	var arr [64]byte
+	return string(f.Append(arr[:0], d))
+}
+
+// FormatFixed formats a fixed-point value as a string.
+func (f Formatter) FormatFixed(v Fixed) string {
+	return f.Format(v.Decimal())
+}
+
+// AppendFixed appends the formatted fixed-point value to dst and returns the extended buffer.
+func (f Formatter) AppendFixed(dst []byte, v Fixed) []byte {
+	return f.Append(dst, v.Decimal())
+}
+
+// Append appends the formatted decimal value to dst and returns the extended buffer.
+func (f Formatter) Append(dst []byte, d Decimal) []byte {
+	d = d.RoundMode(min(d.Digits, max(f.MaxFraction, f.MinFraction)), f.Rounding).Truncate()
+	if digits := min(f.MinFraction, 19); d.Digits < digits {
+		d = d.ToDigits(digits)
+	}
+
+	neg := d.Negative
+	d.Negative = false
+	var arr [48]byte
+	pos := d.text(&arr)
+	integer := arr[pos:]
+	var fraction []byte
+	if d.Digits > 0 {
+		fraction = integer[len(integer)-int(d.Digits):]
+		integer = integer[:len(integer)-int(d.Digits)-1]
+	}
+
+	switch {
+	case neg && f.Sign == SignAccounting:
+		dst = append(dst, '(')
+	case neg:
+		dst = append(dst, f.Locale.minus()...)
+	case f.Sign == SignAlways:
+		dst = append(dst, '+')
+	}
+	if f.Symbol != "" {
+		switch f.SymbolPlacement {
+		case SymbolBefore:
+			dst = append(dst, f.Symbol...)
+		case SymbolBeforeSpace:
+			dst = append(dst, f.Symbol...)
+			dst = append(dst, "\u00a0"...)
+		}
+	}
+	dst = f.Locale.appendGrouped(dst, integer)
+	if len(fraction) > 0 {
+		dst = append(dst, f.Locale.decimal()...)
+		dst = append(dst, fraction...)
+	}
+	for i := uint8(19); i < f.MinFraction; i++ {
+		dst = append(dst, '0')
+	}
+	if f.Symbol != "" {
+		switch f.SymbolPlacement {
+		case SymbolAfter:
+			dst = append(dst, f.Symbol...)
+		case SymbolAfterSpace:
+			dst = append(dst, "\u00a0"...)
+			dst = append(dst, f.Symbol...)
+		}
+	}
+	if neg && f.Sign == SignAccounting {
+		dst = append(dst, ')')
+	}
+	return dst
+}
+
+// appendGrouped appends the integer digits to dst, inserting group separators according to the locale.
+func (l Locale) appendGrouped(dst []byte, integer []byte) []byte {
+	if l.Group == "" {
+		return append(dst, integer...)
+	}
+	var split [21]bool
+	for i, at := 0, 0; ; i++ {
+		at += l.groupSize(i)
+		if at >= len(integer) {
+			break
+		}
+		split[at] = true
+	}
+	for i, c := range integer {
+		if i > 0 && split[len(integer)-i] {
+			dst = append(dst, l.Group...)
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
@@ -0,0 +1,114 @@
+package decimal_test
+
+import (
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+var benchmarkAppendSink []byte
+
+func TestFormatter_Format(t *testing.T) {
+	value := decimal.Decimal{Integer: 1234567, Fraction: 891, Digits: 3}
+	negative := decimal.Decimal{Negative: true, Integer: 1234, Fraction: 56, Digits: 2}
+	tests := []struct {
+		name string
+		f    decimal.Formatter
+		d    decimal.Decimal
+		want string
+	}{
+		{"zero_value", decimal.Formatter{}, value, "1234568"},
+		{"en", decimal.Formatter{Locale: localeEN, MaxFraction: 2}, value, "1,234,567.89"},
+		{"de", decimal.Formatter{Locale: localeDE, MaxFraction: 2}, value, "1.234.567,89"},
+		{"ch", decimal.Formatter{Locale: localeCH, MaxFraction: 2}, value, "1'234'567.89"},
+		{"in", decimal.Formatter{Locale: localeIN, MaxFraction: 2}, value, "12,34,567.89"},
+		{"short_integer", decimal.Formatter{Locale: localeEN}, decimal.Decimal{Integer: 123}, "123"},
+		{"exact_group", decimal.Formatter{Locale: localeEN}, decimal.Decimal{Integer: 123456}, "123,456"},
+		{"max_uint64", decimal.Formatter{Locale: localeEN}, decimal.Decimal{Integer: 18446744073709551615}, "18,446,744,073,709,551,615"},
+		{"rounding_mode", decimal.Formatter{Locale: localeEN, MaxFraction: 2, Rounding: decimal.RoundDown}, value, "1,234,567.89"},
+		{"rounding_carry", decimal.Formatter{Locale: localeEN, MaxFraction: 2}, decimal.Decimal{Integer: 999, Fraction: 996, Digits: 3}, "1,000"},
+		{"strip_trailing_zeros", decimal.Formatter{Locale: localeEN, MaxFraction: 4}, decimal.Decimal{Integer: 1, Fraction: 5000, Digits: 4}, "1.5"},
+		{"min_fraction", decimal.Formatter{Locale: localeEN, MinFraction: 2, MaxFraction: 4}, decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, "1.50"},
+		{"min_fraction_keeps_digits", decimal.Formatter{Locale: localeEN, MinFraction: 2, MaxFraction: 4}, decimal.Decimal{Integer: 1, Fraction: 125, Digits: 3}, "1.125"},
+		{"min_raises_max", decimal.Formatter{Locale: localeEN, MinFraction: 2}, value, "1,234,567.89"},
+		{"min_beyond_limit", decimal.Formatter{MinFraction: 21}, decimal.Decimal{Integer: 1}, "1.000000000000000000000"},
+		{"negative", decimal.Formatter{Locale: localeEN, MinFraction: 2, MaxFraction: 2}, negative, "-1,234.56"},
+		{"negative_locale_minus", decimal.Formatter{Locale: decimal.Locale{Minus: []string{"−", "-"}}, MaxFraction: 2}, negative, "−1234.56"},
+		{"negative_rounds_to_zero", decimal.Formatter{}, decimal.Decimal{Negative: true, Fraction: 4, Digits: 1}, "0"},
+		{"sign_always", decimal.Formatter{Locale: localeEN, MaxFraction: 2, Sign: decimal.SignAlways}, decimal.Decimal{Integer: 5}, "+5"},
+		{"sign_always_negative", decimal.Formatter{Locale: localeEN, MaxFraction: 2, Sign: decimal.SignAlways}, negative, "-1,234.56"},
+		{"accounting", decimal.Formatter{Locale: localeEN, MaxFraction: 2, Sign: decimal.SignAccounting}, negative, "(1,234.56)"},
+		{"accounting_positive", decimal.Formatter{Locale: localeEN, MaxFraction: 2, Sign: decimal.SignAccounting}, decimal.Decimal{Integer: 5}, "5"},
+		{"symbol_before", decimal.Formatter{Locale: localeEN, MinFraction: 2, Symbol: "$"}, negative, "-$1,234.56"},
+		{"symbol_before_space", decimal.Formatter{Locale: localeCH, MinFraction: 2, Symbol: "CHF", SymbolPlacement: decimal.SymbolBeforeSpace}, value, "CHF\u00a01'234'567.89"},
+		{"symbol_after", decimal.Formatter{Locale: localeDE, MinFraction: 2, Symbol: "€", SymbolPlacement: decimal.SymbolAfter}, value, "1.234.567,89€"},
+		{"symbol_after_space", decimal.Formatter{Locale: localeDE, MinFraction: 2, Symbol: "€", SymbolPlacement: decimal.SymbolAfterSpace}, negative, "-1.234,56\u00a0€"},
+		{"symbol_accounting", decimal.Formatter{Locale: localeEN, MinFraction: 2, Symbol: "$", Sign: decimal.SignAccounting}, negative, "($1,234.56)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Format(tt.d); got != tt.want {
+				t.Errorf("Formatter.Format() = %q, want %q", got, tt.want)
+			}
+			if got := string(tt.f.Append([]byte("prefix:"), tt.d)); got != "prefix:"+tt.want {
+				t.Errorf("Formatter.Append() = %q, want %q", got, "prefix:"+tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_FormatFixed(t *testing.T) {
+	tests := []struct {
+		name string
+		f    decimal.Formatter
+		v    decimal.Fixed
+		want string
+	}{
+		{"en", decimal.Formatter{Locale: localeEN, MinFraction: 2}, 123456789, "1,234,567.89"},
+		{"de_symbol", decimal.Formatter{Locale: localeDE, MinFraction: 2, Symbol: "€", SymbolPlacement: decimal.SymbolAfterSpace}, -150, "-1,50\u00a0€"},
+		{"accounting", decimal.Formatter{Locale: localeEN, MinFraction: 2, Sign: decimal.SignAccounting}, -2147483648, "(21,474,836.48)"},
+		{"strip_zeros", decimal.Formatter{Locale: localeEN, MaxFraction: 2}, 1250, "12.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.FormatFixed(tt.v); got != tt.want {
+				t.Errorf("Formatter.FormatFixed() = %q, want %q", got, tt.want)
+			}
+			if got := string(tt.f.AppendFixed(nil, tt.v)); got != tt.want {
+				t.Errorf("Formatter.AppendFixed() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseRoundTrip(t *testing.T) {
+	locales := []decimal.Locale{localeEN, localeDE, localeCH, localeIN}
+	values := []decimal.Decimal{
+		{},
+		{Integer: 1},
+		{Integer: 1234567, Fraction: 89, Digits: 2},
+		{Negative: true, Integer: 18446744073709551615, Fraction: 1, Digits: 19},
+	}
+	for _, loc := range locales {
+		f := decimal.Formatter{Locale: loc, MaxFraction: 19}
+		for _, v := range values {
+			s := f.Format(v)
+			got, err := decimal.ParseLocale(s, loc)
+			if err != nil {
+				t.Fatalf("ParseLocale(%q) error = %v", s, err)
+			}
+			if !decimal.Equal(got, v) {
+				t.Errorf("ParseLocale(%q) = %v, want %v", s, got, v)
+			}
+		}
+	}
+}
+
+func BenchmarkFormatter_Append(b *testing.B) {
+	f := decimal.Formatter{Locale: localeDE, MinFraction: 2, Symbol: "€", SymbolPlacement: decimal.SymbolAfterSpace}
+	d := decimal.Decimal{Integer: 1234567, Fraction: 891, Digits: 3}
+	buf := make([]byte, 0, 64)
+	for b.Loop() {
+		benchmarkAppendSink = f.Append(buf[:0], d)
+	}
+}
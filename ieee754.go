@@ -0,0 +1,331 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// ieeeFormat describes an IEEE 754-2008 decimal interchange format.
+// Encoded values are handled as 128-bit integers hi:lo, decimal64 uses only lo.
+type ieeeFormat struct {
+	name    string
+	width   uint // total number of bits
+	expCont uint // bits of the exponent continuation, the exponent has two more bits
+	digits  int  // number of coefficient digits
+	bias    int  // exponent bias
+}
+
+var (
+	decimal64  = ieeeFormat{"decimal64", 64, 8, 16, 398}
+	decimal128 = ieeeFormat{"decimal128", 128, 12, 34, 6176}
+)
+
+// ToDecimal64BID encodes the decimal value as an IEEE 754 decimal64 with binary integer coefficient in big-endian byte order.
+// The exponent preserves the number of fractional digits unless trailing zeros must be removed to fit 16 significant digits.
+// Values with more than 16 significant digits return an error.
+func (d Decimal) ToDecimal64BID() ([8]byte, error) {
+	var b [8]byte
+	_, lo, err := decimal64.encodeBID(d)
+	binary.BigEndian.PutUint64(b[:], lo)
+	return b, err
+}
+
+// ToDecimal64DPD encodes the decimal value as an IEEE 754 decimal64 with densely packed decimal coefficient in big-endian byte order.
+// It follows the rules of `ToDecimal64BID`.
+func (d Decimal) ToDecimal64DPD() ([8]byte, error) {
+	var b [8]byte
+	_, lo, err := decimal64.encodeDPD(d)
+	binary.BigEndian.PutUint64(b[:], lo)
+	return b, err
+}
+
+// ToDecimal128BID encodes the decimal value as an IEEE 754 decimal128 with binary integer coefficient in big-endian byte order.
+// The exponent preserves the number of fractional digits unless trailing zeros must be removed to fit 34 significant digits.
+// Values with more than 34 significant digits return an error.
+func (d Decimal) ToDecimal128BID() ([16]byte, error) {
+	var b [16]byte
+	hi, lo, err := decimal128.encodeBID(d)
+	binary.BigEndian.PutUint64(b[:], hi)
+	binary.BigEndian.PutUint64(b[8:], lo)
+	return b, err
+}
+
+// ToDecimal128DPD encodes the decimal value as an IEEE 754 decimal128 with densely packed decimal coefficient in big-endian byte order.
+// It follows the rules of `ToDecimal128BID`.
+func (d Decimal) ToDecimal128DPD() ([16]byte, error) {
+	var b [16]byte
+	hi, lo, err := decimal128.encodeDPD(d)
+	binary.BigEndian.PutUint64(b[:], hi)
+	binary.BigEndian.PutUint64(b[8:], lo)
+	return b, err
+}
+
+// FromDecimal64BID decodes an IEEE 754 decimal64 with binary integer coefficient in big-endian byte order.
+// The number of fractional digits is taken from the exponent, trailing zeros are only removed if there are more than 19.
+// NaN, infinities and values outside the range of `Decimal` return an error. Negative zero is decoded as zero.
+func FromDecimal64BID(b [8]byte) (Decimal, error) {
+	return decimal64.decodeBID(0, binary.BigEndian.Uint64(b[:]))
+}
+
+// FromDecimal64DPD decodes an IEEE 754 decimal64 with densely packed decimal coefficient in big-endian byte order.
+// It follows the rules of `FromDecimal64BID`.
+func FromDecimal64DPD(b [8]byte) (Decimal, error) {
+	return decimal64.decodeDPD(0, binary.BigEndian.Uint64(b[:]))
+}
+
+// FromDecimal128BID decodes an IEEE 754 decimal128 with binary integer coefficient in big-endian byte order.
+// It follows the rules of `FromDecimal64BID`.
+func FromDecimal128BID(b [16]byte) (Decimal, error) {
+	return decimal128.decodeBID(binary.BigEndian.Uint64(b[:]), binary.BigEndian.Uint64(b[8:]))
+}
+
+// FromDecimal128DPD decodes an IEEE 754 decimal128 with densely packed decimal coefficient in big-endian byte order.
+// It follows the rules of `FromDecimal64BID`.
+func FromDecimal128DPD(b [16]byte) (Decimal, error) {
+	return decimal128.decodeDPD(binary.BigEndian.Uint64(b[:]), binary.BigEndian.Uint64(b[8:]))
+}
+
+// coefficientBits returns the number of bits of the coefficient in the BID encoding with an exponent in the leading bits.
+func (f ieeeFormat) coefficientBits() uint {
+	return f.width - 1 - (f.expCont + 2)
+}
+
+// coefficientLimit returns 10^digits as hi:lo.
+func (f ieeeFormat) coefficientLimit() (uint64, uint64) {
+	if f.digits <= 19 {
+		return 0, pow10[f.digits]
+	}
+	return bits.Mul64(pow10[19], pow10[f.digits-19])
+}
+
+// coefficient returns the coefficient of the decimal value as hi:lo and its exponent.
+// Trailing zeros are only removed if the coefficient has more digits than the format supports.
+func (f ieeeFormat) coefficient(d Decimal) (hi, lo uint64, exp int, err error) {
+	hi, lo = bits.Mul64(d.Integer, pow10[d.Digits])
+	var carry uint64
+	lo, carry = bits.Add64(lo, d.Fraction, 0)
+	hi += carry
+	exp = -int(d.Digits)
+	limHi, limLo := f.coefficientLimit()
+	for hi > limHi || hi == limHi && lo >= limLo {
+		var r uint64
+		if hi, lo, r = div128by64(hi, lo, 10); r != 0 {
+			return 0, 0, 0, fmt.Errorf("%s: value has more than %d significant digits: %v", f.name, f.digits, d)
+		}
+		exp++
+	}
+	return hi, lo, exp, nil
+}
+
+// decimal converts the sign, coefficient hi:lo and exponent of a decoded value to a decimal value.
+func (f ieeeFormat) decimal(neg bool, hi, lo uint64, exp int) (Decimal, error) {
+	if hi == 0 && lo == 0 {
+		return Decimal{Digits: uint8(min(max(-exp, 0), 19))}, nil
+	}
+	for exp < -19 {
+		var r uint64
+		if hi, lo, r = div128by64(hi, lo, 10); r != 0 {
+			return Zero(), fmt.Errorf("%s: value has more than 19 fractional digits", f.name)
+		}
+		exp++
+	}
+	d := Decimal{Negative: neg}
+	if exp >= 0 {
+		if hi != 0 {
+			return Zero(), fmt.Errorf("%s: value overflows unsigned 64-bit integer", f.name)
+		}
+		for ; exp > 0; exp-- {
+			if hi, lo = bits.Mul64(lo, 10); hi != 0 {
+				return Zero(), fmt.Errorf("%s: value overflows unsigned 64-bit integer", f.name)
+			}
+		}
+		d.Integer = lo
+		return d, nil
+	}
+	d.Digits = uint8(-exp)
+	if hi >= pow10[d.Digits] {
+		return Zero(), fmt.Errorf("%s: value overflows unsigned 64-bit integer", f.name)
+	}
+	d.Integer, d.Fraction = bits.Div64(hi, lo, pow10[d.Digits])
+	return d, nil
+}
+
+// special returns an error for NaN and infinities which are marked by the combination field.
+func (f ieeeFormat) special(hi, lo uint64) error {
+	switch getBits(hi, lo, f.width-6, 5) {
+	case 0b11110:
+		return fmt.Errorf("%s: cannot represent infinity as decimal", f.name)
+	case 0b11111:
+		return fmt.Errorf("%s: cannot represent NaN as decimal", f.name)
+	}
+	return nil
+}
+
+func (f ieeeFormat) encodeBID(d Decimal) (uint64, uint64, error) {
+	hi, lo, exp, err := f.coefficient(d)
+	if err != nil {
+		return 0, 0, err
+	}
+	biased := uint64(exp + f.bias)
+	cb := f.coefficientBits()
+	if getBits(hi, lo, cb, f.width-cb) != 0 {
+		// The coefficient exceeds the bits available, so it is stored with an implicit prefix 0b100.
+		// This only happens for decimal64 as the decimal128 coefficient always fits.
+		lo &= 1<<(cb-2) - 1
+		hi, lo = orBits(hi, lo, cb-2, biased)
+		hi, lo = orBits(hi, lo, f.width-3, 0b11)
+	} else {
+		hi, lo = orBits(hi, lo, cb, biased)
+	}
+	if d.Negative {
+		hi, lo = orBits(hi, lo, f.width-1, 1)
+	}
+	return hi, lo, nil
+}
+
+func (f ieeeFormat) decodeBID(hi, lo uint64) (Decimal, error) {
+	if err := f.special(hi, lo); err != nil {
+		return Zero(), err
+	}
+	neg := getBits(hi, lo, f.width-1, 1) == 1
+	cb := f.coefficientBits()
+	var exp uint64
+	var chi, clo uint64
+	if getBits(hi, lo, f.width-3, 2) == 0b11 {
+		exp = getBits(hi, lo, cb-2, f.expCont+2)
+		if f.width == 64 {
+			clo = 0b100<<(cb-2) | getBits(hi, lo, 0, cb-2)
+		}
+		// Coefficients of decimal128 in this form always exceed the limit and are treated as zero by the standard.
+	} else {
+		exp = getBits(hi, lo, cb, f.expCont+2)
+		clo = getBits(hi, lo, 0, min(cb, 64))
+		if cb > 64 {
+			chi = getBits(hi, lo, 64, cb-64)
+		}
+	}
+	if limHi, limLo := f.coefficientLimit(); chi > limHi || chi == limHi && clo >= limLo {
+		chi, clo = 0, 0
+	}
+	return f.decimal(neg, chi, clo, int(exp)-f.bias)
+}
+
+func (f ieeeFormat) encodeDPD(d Decimal) (uint64, uint64, error) {
+	chi, clo, exp, err := f.coefficient(d)
+	if err != nil {
+		return 0, 0, err
+	}
+	var digits [34]byte
+	for i := f.digits - 1; i >= 0; i-- {
+		var r uint64
+		chi, clo, r = div128by64(chi, clo, 10)
+		digits[i] = byte(r)
+	}
+	var hi, lo uint64
+	declets := (f.digits - 1) / 3
+	for i := range declets {
+		v := uint16(digits[1+3*i])*100 + uint16(digits[2+3*i])*10 + uint16(digits[3+3*i])
+		hi, lo = orBits(hi, lo, uint(10*(declets-1-i)), uint64(dpdEncode[v]))
+	}
+	biased := uint64(exp + f.bias)
+	hi, lo = orBits(hi, lo, uint(10*declets), biased&(1<<f.expCont-1))
+	msb, d0 := biased>>f.expCont, uint64(digits[0])
+	if d0 < 8 {
+		hi, lo = orBits(hi, lo, f.width-6, msb<<3|d0)
+	} else {
+		hi, lo = orBits(hi, lo, f.width-6, 0b11000|msb<<1|d0&1)
+	}
+	if d.Negative {
+		hi, lo = orBits(hi, lo, f.width-1, 1)
+	}
+	return hi, lo, nil
+}
+
+func (f ieeeFormat) decodeDPD(hi, lo uint64) (Decimal, error) {
+	if err := f.special(hi, lo); err != nil {
+		return Zero(), err
+	}
+	neg := getBits(hi, lo, f.width-1, 1) == 1
+	comb := getBits(hi, lo, f.width-6, 5)
+	var msb, clo, chi uint64
+	if comb>>3 == 0b11 {
+		msb, clo = comb>>1&0b11, 8|comb&1
+	} else {
+		msb, clo = comb>>3, comb&0b111
+	}
+	declets := (f.digits - 1) / 3
+	exp := msb<<f.expCont | getBits(hi, lo, uint(10*declets), f.expCont)
+	for i := declets - 1; i >= 0; i-- {
+		h, l := bits.Mul64(clo, 1000)
+		var carry uint64
+		clo, carry = bits.Add64(l, uint64(dpdDecode[getBits(hi, lo, uint(10*i), 10)]), 0)
+		chi = chi*1000 + h + carry
+	}
+	return f.decimal(neg, chi, clo, int(exp)-f.bias)
+}
+
+// div128by64 divides hi:lo by v and returns the quotient and remainder.
+func div128by64(hi, lo, v uint64) (uint64, uint64, uint64) {
+	qlo, r := bits.Div64(hi%v, lo, v)
+	return hi / v, qlo, r
+}
+
+// getBits returns n bits of hi:lo starting at bit off.
+func getBits(hi, lo uint64, off, n uint) uint64 {
+	var v uint64
+	if off >= 64 {
+		v = hi >> (off - 64)
+	} else {
+		v = lo>>off | hi<<(64-off)
+	}
+	return v & (uint64(1)<<n - 1)
+}
+
+// orBits combines v shifted to bit off with hi:lo.
+func orBits(hi, lo uint64, off uint, v uint64) (uint64, uint64) {
+	if off >= 64 {
+		return hi | v<<(off-64), lo
+	}
+	return hi | v>>(64-off), lo | v<<off
+}
+
+// dpdDecode maps each 10-bit declet to its value in 0-999, dpdEncode maps values to their canonical declet.
+var dpdDecode, dpdEncode = dpdTables()
+
+// dpdTables builds the densely packed decimal tables from the decoding rules of IEEE 754-2008 Table 3.3.
+func dpdTables() (dec [1024]uint16, enc [1000]uint16) {
+	for i := range enc {
+		enc[i] = 0xffff
+	}
+	for declet := range uint16(1024) {
+		b := func(i int) uint16 { return declet >> i & 1 }
+		p, q, r, s, t, u, v, w, x, y := b(9), b(8), b(7), b(6), b(5), b(4), b(3), b(2), b(1), b(0)
+		var d1, d2, d3 uint16
+		switch {
+		case v == 0:
+			d1, d2, d3 = 4*p+2*q+r, 4*s+2*t+u, 4*w+2*x+y
+		case w == 0 && x == 0:
+			d1, d2, d3 = 4*p+2*q+r, 4*s+2*t+u, 8+y
+		case w == 0 && x == 1:
+			d1, d2, d3 = 4*p+2*q+r, 8+u, 4*s+2*t+y
+		case w == 1 && x == 0:
+			d1, d2, d3 = 8+r, 4*s+2*t+u, 4*p+2*q+y
+		case s == 0 && t == 0:
+			d1, d2, d3 = 8+r, 8+u, 4*p+2*q+y
+		case s == 0 && t == 1:
+			d1, d2, d3 = 8+r, 4*p+2*q+u, 8+y
+		case s == 1 && t == 0:
+			d1, d2, d3 = 4*p+2*q+r, 8+u, 8+y
+		default:
+			d1, d2, d3 = 8+r, 8+u, 8+y
+		}
+		value := d1*100 + d2*10 + d3
+		dec[declet] = value
+		// Values with three large digits have four encodings, the canonical one has the lowest declet.
+		if enc[value] == 0xffff {
+			enc[value] = declet
+		}
+	}
+	return dec, enc
+}
@@ -0,0 +1,198 @@
+package decimal_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestDecimal_IEEE754(t *testing.T) {
+	tests := []struct {
+		name   string
+		d      decimal.Decimal
+		bid64  string
+		dpd64  string
+		bid128 string
+		dpd128 string
+	}{
+		{
+			name:   "one",
+			d:      decimal.Decimal{Integer: 1},
+			bid64:  "31c0000000000001",
+			dpd64:  "2238000000000001",
+			bid128: "30400000000000000000000000000001",
+			dpd128: "22080000000000000000000000000001",
+		},
+		{
+			name:   "negative_scale",
+			d:      decimal.Decimal{Negative: true, Integer: 7, Fraction: 50, Digits: 2},
+			bid64:  "b1800000000002ee",
+			dpd64:  "a2300000000003d0",
+			bid128: "b03c00000000000000000000000002ee",
+			dpd128: "a20780000000000000000000000003d0",
+		},
+		{
+			name:   "hundredth",
+			d:      decimal.Decimal{Negative: true, Fraction: 1, Digits: 2},
+			bid64:  "b180000000000001",
+			dpd64:  "a230000000000001",
+			bid128: "b03c0000000000000000000000000001",
+			dpd128: "a2078000000000000000000000000001",
+		},
+		{
+			name:   "max_decimal64_coefficient",
+			d:      decimal.Decimal{Integer: 9999999999999999},
+			bid64:  "6c7386f26fc0ffff",
+			dpd64:  "6e38ff3fcff3fcff",
+			bid128: "3040000000000000002386f26fc0ffff",
+			dpd128: "22080000000000000024ff3fcff3fcff",
+		},
+		{
+			name:   "zero_with_digits",
+			d:      decimal.Decimal{Digits: 3},
+			bid64:  "3160000000000000",
+			dpd64:  "222c000000000000",
+			bid128: "303a0000000000000000000000000000",
+			dpd128: "22074000000000000000000000000000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(name string, got []byte, err error, want string, decode func([]byte) (decimal.Decimal, error)) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("%s() error = %v", name, err)
+				}
+				if hex.EncodeToString(got) != want {
+					t.Errorf("%s() = %x, want %s", name, got, want)
+				}
+				back, err := decode(got)
+				if err != nil {
+					t.Fatalf("decoding %s %x error = %v", name, got, err)
+				}
+				if back != tt.d {
+					t.Errorf("decoding %s %x = %#v, want %#v", name, got, back, tt.d)
+				}
+			}
+			b64, err := tt.d.ToDecimal64BID()
+			check("ToDecimal64BID", b64[:], err, tt.bid64, func(b []byte) (decimal.Decimal, error) { return decimal.FromDecimal64BID([8]byte(b)) })
+			d64, err := tt.d.ToDecimal64DPD()
+			check("ToDecimal64DPD", d64[:], err, tt.dpd64, func(b []byte) (decimal.Decimal, error) { return decimal.FromDecimal64DPD([8]byte(b)) })
+			b128, err := tt.d.ToDecimal128BID()
+			check("ToDecimal128BID", b128[:], err, tt.bid128, func(b []byte) (decimal.Decimal, error) { return decimal.FromDecimal128BID([16]byte(b)) })
+			d128, err := tt.d.ToDecimal128DPD()
+			check("ToDecimal128DPD", d128[:], err, tt.dpd128, func(b []byte) (decimal.Decimal, error) { return decimal.FromDecimal128DPD([16]byte(b)) })
+		})
+	}
+}
+
+func TestDecimal_IEEE754_Decimal128Only(t *testing.T) {
+	tests := []struct {
+		name   string
+		d      decimal.Decimal
+		bid128 string
+		dpd128 string
+	}{
+		{"max_uint64", decimal.Decimal{Integer: 18446744073709551615}, "3040000000000000ffffffffffffffff", "2208000000000001891bc41cf89b4715"},
+		{"34_digits", decimal.Decimal{Integer: 123456789012345, Fraction: 6789012345678901234, Digits: 19}, "301a3cde6fff9732de825cd07e96aff2", "2603534b9c1e28e56f3c127177823534"},
+		{"19_fractional_digits", decimal.Decimal{Negative: true, Integer: 12, Fraction: 123456789, Digits: 19}, "b01a0000000000068155a4367e3bcd15", "a20340000000000a000000000a395bcf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.d.ToDecimal64BID(); err == nil {
+				t.Errorf("ToDecimal64BID() succeeded for more than 16 significant digits")
+			}
+			bid, err := tt.d.ToDecimal128BID()
+			if err != nil || hex.EncodeToString(bid[:]) != tt.bid128 {
+				t.Errorf("ToDecimal128BID() = %x, %v, want %s", bid, err, tt.bid128)
+			}
+			dpd, err := tt.d.ToDecimal128DPD()
+			if err != nil || hex.EncodeToString(dpd[:]) != tt.dpd128 {
+				t.Errorf("ToDecimal128DPD() = %x, %v, want %s", dpd, err, tt.dpd128)
+			}
+			if got, err := decimal.FromDecimal128BID(bid); err != nil || got != tt.d {
+				t.Errorf("FromDecimal128BID(%x) = %#v, %v, want %#v", bid, got, err, tt.d)
+			}
+			if got, err := decimal.FromDecimal128DPD(dpd); err != nil || got != tt.d {
+				t.Errorf("FromDecimal128DPD(%x) = %#v, %v, want %#v", dpd, got, err, tt.d)
+			}
+		})
+	}
+}
+
+func TestDecimal_ToDecimal64_TrailingZeros(t *testing.T) {
+	// 17 digits with a trailing zero fit into 16 digits by raising the exponent.
+	d := decimal.Decimal{Integer: 123456789012345, Fraction: 60, Digits: 2}
+	b, err := d.ToDecimal64BID()
+	if err != nil {
+		t.Fatalf("ToDecimal64BID() error = %v", err)
+	}
+	got, err := decimal.FromDecimal64BID(b)
+	if want := (decimal.Decimal{Integer: 123456789012345, Fraction: 6, Digits: 1}); err != nil || got != want {
+		t.Errorf("FromDecimal64BID(%x) = %#v, %v, want %#v", b, got, err, want)
+	}
+	if _, err := (decimal.Decimal{Integer: 123456789012345, Fraction: 67, Digits: 2}).ToDecimal64DPD(); err == nil {
+		t.Errorf("ToDecimal64DPD() succeeded for 17 significant digits")
+	}
+}
+
+func TestFromDecimal64_Special(t *testing.T) {
+	tests := []struct {
+		name    string
+		bid     string
+		dpd     string
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"infinity", "7800000000000000", "7800000000000000", decimal.Decimal{}, true},
+		{"negative_infinity", "f800000000000000", "f800000000000000", decimal.Decimal{}, true},
+		{"nan", "7c00000000000000", "7c00000000000000", decimal.Decimal{}, true},
+		{"signaling_nan", "7e00000000000000", "7e00000000000000", decimal.Decimal{}, true},
+		{"negative_zero", "b1c0000000000000", "a238000000000000", decimal.Decimal{}, false},
+		{"positive_exponent", "31e0000000000005", "223c000000000005", decimal.Decimal{Integer: 50}, false},
+		{"integer_overflow", "3440000000000001", "2288000000000001", decimal.Decimal{}, true},
+		{"zero_large_exponent", "5fe0000000000000", "43fc000000000000", decimal.Decimal{}, false},
+		{"too_many_fractional_digits", "2f40000000000001", "21e8000000000001", decimal.Decimal{}, true},
+		{"trailing_zeros_beyond_19_digits", "2f20000000000064", "21e4000000000080", decimal.Decimal{Fraction: 1, Digits: 19}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bid, dpd [8]byte
+			hex.Decode(bid[:], []byte(tt.bid))
+			hex.Decode(dpd[:], []byte(tt.dpd))
+			got, err := decimal.FromDecimal64BID(bid)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("FromDecimal64BID(%s) = %#v, %v, want %#v, error %v", tt.bid, got, err, tt.want, tt.wantErr)
+			}
+			got, err = decimal.FromDecimal64DPD(dpd)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("FromDecimal64DPD(%s) = %#v, %v, want %#v, error %v", tt.dpd, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFromDecimal128BID_NonCanonical(t *testing.T) {
+	// Coefficients above 10^34-1 are non-canonical and decode as zero.
+	var b [16]byte
+	hex.Decode(b[:], []byte("3041ed09bead87c0378d8e6400000000"))
+	got, err := decimal.FromDecimal128BID(b)
+	if err != nil || got != (decimal.Decimal{}) {
+		t.Errorf("FromDecimal128BID(%x) = %#v, %v, want zero", b, got, err)
+	}
+}
+
+func BenchmarkDecimal_ToDecimal64DPD(b *testing.B) {
+	d := decimal.Decimal{Negative: true, Integer: 1234, Fraction: 5678, Digits: 4}
+	for b.Loop() {
+		_, _ = d.ToDecimal64DPD()
+	}
+}
+
+func BenchmarkFromDecimal128DPD(b *testing.B) {
+	data, _ := decimal.Decimal{Integer: 123456789012345, Fraction: 6789012345678901234, Digits: 19}.ToDecimal128DPD()
+	for b.Loop() {
+		_, _ = decimal.FromDecimal128DPD(data)
+	}
+}
@@ -0,0 +1,263 @@
+// Command cldrgen generates the bundled locale table from CLDR number formatting data.
+//
+// By default it reads the checked-in numbers.tsv and writes locale_table.go.
+// With -cldr, the data is first refreshed from a local copy of the CLDR JSON number data
+// (the main directory of cldr-numbers-full), so the table can be updated without network access.
+// With -update, the refreshed data is written back to numbers.tsv.
+//
+// Usage from the repository root:
+//
+//	go run ./internal/cldrgen [-cldr path/to/cldr-numbers-full/main] [-update]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type record struct {
+	Tag      string // BCP 47 tag used for lookups
+	Source   string // CLDR locale the data is taken from
+	Decimal  string
+	Group    string
+	Minus    string
+	Pattern  string // CLDR decimal format pattern
+	Currency string // CLDR currency format pattern
+}
+
+func main() {
+	data := flag.String("data", "internal/cldrgen/numbers.tsv", "path of the checked-in locale data")
+	out := flag.String("out", "locale_table.go", "path of the generated Go file")
+	cldr := flag.String("cldr", "", "optional path of the main directory of a local cldr-numbers-full checkout")
+	update := flag.Bool("update", false, "write the (refreshed) locale data back to the data file")
+	flag.Parse()
+
+	header, records, err := readData(*data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *cldr != "" {
+		for i := range records {
+			if err := refresh(&records[i], *cldr); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if *update {
+		if err := writeData(*data, header, records); err != nil {
+			log.Fatal(err)
+		}
+	}
+	src, err := generate(records)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readData reads the tab separated locale data, returning the leading comment lines separately.
+func readData(path string) ([]string, []record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	var header []string
+	var records []record
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" {
+			continue
+		}
+		if strings.HasPrefix(text, "#") {
+			header = append(header, text)
+			continue
+		}
+		cols := strings.Split(text, "\t")
+		if len(cols) != 7 {
+			return nil, nil, fmt.Errorf("%s:%d: expected 7 columns, got %d", path, line, len(cols))
+		}
+		for i := 2; i < len(cols); i++ {
+			if cols[i], err = strconv.Unquote(cols[i]); err != nil {
+				return nil, nil, fmt.Errorf("%s:%d: column %d: %w", path, line, i+1, err)
+			}
+		}
+		records = append(records, record{cols[0], cols[1], cols[2], cols[3], cols[4], cols[5], cols[6]})
+	}
+	return header, records, scanner.Err()
+}
+
+func writeData(path string, header []string, records []record) error {
+	var buf bytes.Buffer
+	for _, h := range header {
+		buf.WriteString(h)
+		buf.WriteByte('\n')
+	}
+	for _, r := range records {
+		fmt.Fprintf(&buf, "%s\t%s\t%q\t%q\t%q\t%q\t%q\n", r.Tag, r.Source, r.Decimal, r.Group, r.Minus, r.Pattern, r.Currency)
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// refresh replaces the symbols and patterns of a record with the values found in the CLDR JSON data.
+func refresh(r *record, dir string) error {
+	raw, err := os.ReadFile(filepath.Join(dir, r.Source, "numbers.json"))
+	if err != nil {
+		return err
+	}
+	var doc struct {
+		Main map[string]struct {
+			Numbers struct {
+				Symbols struct {
+					Decimal   string `json:"decimal"`
+					Group     string `json:"group"`
+					MinusSign string `json:"minusSign"`
+				} `json:"symbols-numberSystem-latn"`
+				DecimalFormats struct {
+					Standard string `json:"standard"`
+				} `json:"decimalFormats-numberSystem-latn"`
+				CurrencyFormats struct {
+					Standard string `json:"standard"`
+				} `json:"currencyFormats-numberSystem-latn"`
+			} `json:"numbers"`
+		} `json:"main"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return fmt.Errorf("%s: %w", r.Source, err)
+	}
+	loc, ok := doc.Main[r.Source]
+	if !ok {
+		return fmt.Errorf("%s: locale missing from numbers.json", r.Source)
+	}
+	n := loc.Numbers
+	if n.Symbols.Decimal == "" || n.DecimalFormats.Standard == "" || n.CurrencyFormats.Standard == "" {
+		return fmt.Errorf("%s: incomplete latn number data", r.Source)
+	}
+	r.Decimal = n.Symbols.Decimal
+	r.Group = n.Symbols.Group
+	r.Minus = n.Symbols.MinusSign
+	r.Pattern = n.DecimalFormats.Standard
+	r.Currency = n.CurrencyFormats.Standard
+	return nil
+}
+
+// grouping extracts the primary and secondary group sizes from a CLDR pattern.
+func grouping(pattern string) ([]uint8, error) {
+	positive, _, _ := strings.Cut(pattern, ";")
+	integer, _, _ := strings.Cut(positive, ".")
+	integer = strings.Map(func(r rune) rune {
+		if r == '#' || r == '0' || r == ',' {
+			return r
+		}
+		return -1
+	}, integer)
+	parts := strings.Split(integer, ",")
+	if len(parts) == 1 {
+		return nil, nil
+	}
+	primary := len(parts[len(parts)-1])
+	if primary == 0 || primary > 9 {
+		return nil, fmt.Errorf("invalid primary grouping in pattern %q", pattern)
+	}
+	if len(parts) > 2 {
+		if secondary := len(parts[len(parts)-2]); secondary != primary && secondary > 0 && secondary <= 9 {
+			return []uint8{uint8(primary), uint8(secondary)}, nil
+		}
+	}
+	return []uint8{uint8(primary)}, nil
+}
+
+// groupAlternates returns the separators that are commonly typed in place of a CLDR group symbol
+// and are accepted when parsing: the ASCII apostrophe for the typographic one and plain spaces for the no-break spaces.
+func groupAlternates(group string) []string {
+	switch group {
+	case "\u2019":
+		return []string{"'"}
+	case "\u202f":
+		return []string{" ", "\u00a0"}
+	case "\u00a0":
+		return []string{" ", "\u202f"}
+	}
+	return nil
+}
+
+// placement determines where the currency symbol is placed in a CLDR currency pattern.
+func placement(pattern string) (string, error) {
+	positive, _, _ := strings.Cut(pattern, ";")
+	symbol := strings.Index(positive, "¤")
+	number := strings.IndexAny(positive, "#0")
+	if symbol < 0 || number < 0 {
+		return "", fmt.Errorf("invalid currency pattern %q", pattern)
+	}
+	if symbol < number {
+		if between := positive[symbol+len("¤") : number]; strings.TrimSpace(between) == "" && between != "" {
+			return "SymbolBeforeSpace", nil
+		}
+		return "SymbolBefore", nil
+	}
+	last := strings.LastIndexAny(positive, "#0")
+	if between := positive[last+1 : symbol]; strings.TrimSpace(between) == "" && between != "" {
+		return "SymbolAfterSpace", nil
+	}
+	return "SymbolAfter", nil
+}
+
+func generate(records []record) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go run ./internal/cldrgen; DO NOT EDIT.\n\n")
+	buf.WriteString("package decimal\n\n")
+	buf.WriteString("// locales contains number formatting data for common locales derived from the Unicode CLDR.\n")
+	buf.WriteString("var locales = map[string]Locale{\n")
+	defaults := map[string]string{}
+	var languages []string
+	for _, r := range records {
+		groups, err := grouping(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.Tag, err)
+		}
+		symbol, err := placement(r.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.Tag, err)
+		}
+		minus := []string{r.Minus}
+		if r.Minus != "-" {
+			minus = append(minus, "-")
+		}
+		sizes := make([]string, len(groups))
+		for i, g := range groups {
+			sizes[i] = strconv.Itoa(int(g))
+		}
+		var alternates string
+		if alt := groupAlternates(r.Group); alt != nil {
+			alternates = fmt.Sprintf(" GroupAlternates: %#v,", alt)
+		}
+		fmt.Fprintf(&buf, "\t%q: {Decimal: %q, Group: %q,%s Grouping: []uint8{%s}, Minus: %#v, CurrencyPlacement: %s},\n",
+			r.Tag, r.Decimal, r.Group, alternates, strings.Join(sizes, ", "), minus, symbol)
+		lang, _, _ := strings.Cut(r.Tag, "-")
+		if _, ok := defaults[lang]; !ok {
+			defaults[lang] = r.Tag
+			languages = append(languages, lang)
+		}
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// localeDefaults maps languages to the locale used when no region is given.\n")
+	buf.WriteString("var localeDefaults = map[string]string{\n")
+	for _, lang := range languages {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", lang, defaults[lang])
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGrouping(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []uint8
+	}{
+		{"#,##0.###", []uint8{3}},
+		{"#,##,##0.###", []uint8{3, 2}},
+		{"¤#,##,##0.00", []uint8{3, 2}},
+		{"#,##0.00\u00a0¤;(#,##0.00\u00a0¤)", []uint8{3}},
+		{"#,###,##0.###", []uint8{3}},
+		{"#0.###", nil},
+	}
+	for _, tt := range tests {
+		got, err := grouping(tt.pattern)
+		if err != nil {
+			t.Fatalf("grouping(%q) error = %v", tt.pattern, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("grouping(%q) = %v, want %v", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestPlacement(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+		wantErr bool
+	}{
+		{"¤#,##0.00", "SymbolBefore", false},
+		{"¤\u00a0#,##0.00;¤-#,##0.00", "SymbolBeforeSpace", false},
+		{"#,##0.00¤", "SymbolAfter", false},
+		{"#,##0.00\u00a0¤", "SymbolAfterSpace", false},
+		{"#,##0.00", "", true},
+	}
+	for _, tt := range tests {
+		got, err := placement(tt.pattern)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("placement(%q) error = %v, wantErr %v", tt.pattern, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("placement(%q) = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestGroupAlternates(t *testing.T) {
+	tests := []struct {
+		group string
+		want  []string
+	}{
+		{"\u2019", []string{"'"}},
+		{"\u202f", []string{" ", "\u00a0"}},
+		{"\u00a0", []string{" ", "\u202f"}},
+		{",", nil},
+		{".", nil},
+	}
+	for _, tt := range tests {
+		if got := groupAlternates(tt.group); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("groupAlternates(%q) = %q, want %q", tt.group, got, tt.want)
+		}
+	}
+}
@@ -38,3 +38,20 @@ func TestDiv128(t *testing.T) {
 		})
 	}
 }
+
+func TestDPDTables(t *testing.T) {
+	for v := range uint16(1000) {
+		if got := dpdDecode[dpdEncode[v]]; got != v {
+			t.Fatalf("dpdDecode[dpdEncode[%d]] = %d", v, got)
+		}
+	}
+	// Spot checks from IEEE 754-2008 Table 3.4.
+	for _, tt := range []struct{ value, declet uint16 }{{0, 0x000}, {9, 0x009}, {19, 0x019}, {99, 0x05f}, {750, 0x3d0}, {999, 0x0ff}} {
+		if got := dpdEncode[tt.value]; got != tt.declet {
+			t.Errorf("dpdEncode[%d] = %#03x, want %#03x", tt.value, got, tt.declet)
+		}
+	}
+	if got := dpdDecode[0x3ff]; got != 999 {
+		t.Errorf("dpdDecode[0x3ff] = %d, want 999", got)
+	}
+}
@@ -1,6 +1,12 @@
 package decimal
 
-import "unsafe"
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+)
 
 // MarshalJSON encodes a decimal value as a JSON number.
 func (d Decimal) MarshalJSON() ([]byte, error) {
@@ -11,16 +17,25 @@ func (d Decimal) MarshalJSON() ([]byte, error) {
 	return b, nil
 }
 
+// AppendJSON appends the decimal value encoded as a JSON number to b and returns the extended buffer.
+func (d Decimal) AppendJSON(b []byte) ([]byte, error) {
+	var arr [48]byte
+	pos := d.text(&arr)
+	return append(b, arr[pos:]...), nil
+}
+
 // UnmarshalJSON decodes a JSON number or string into a decimal value.
-// Strings must be a plain number and may not contain any escaped or non-numeric characters.
+// Surrounding whitespace is ignored and string escapes are decoded,
+// but the content of strings must still be a plain number without any other characters.
 // `null` is decoded as zero to ensure missing values do not stop decoding entirely.
 func (d *Decimal) UnmarshalJSON(data []byte) error {
-	if len(data) == 4 && data[0] == 'n' && data[1] == 'u' && data[2] == 'l' && data[3] == 'l' {
+	if isJSONNull(data) {
 		*d = Zero()
 		return nil
 	}
-	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
-		data = data[1 : len(data)-1]
+	data, err := jsonText(data)
+	if err != nil {
+		return err
 	}
 	val, err := NewFromString(unsafe.String(unsafe.SliceData(data), len(data)))
 	if err != nil {
@@ -39,17 +54,26 @@ func (f Fixed) MarshalJSON() ([]byte, error) {
 	return b, nil
 }
 
+// AppendJSON appends the fixed-point value encoded as a JSON number to b and returns the extended buffer.
+func (f Fixed) AppendJSON(b []byte) ([]byte, error) {
+	var arr [16]byte
+	pos := f.text(&arr)
+	return append(b, arr[pos:]...), nil
+}
+
 // UnmarshalJSON decodes a JSON number or string into a fixed-point value.
-// Strings must be a plain number and may not contain any escaped or non-numeric characters.
+// Surrounding whitespace is ignored and string escapes are decoded,
+// but the content of strings must still be a plain number without any other characters.
 // Fractional digits that cannot be represented are rejected.
 // `null` is decoded as zero to ensure missing values do not stop decoding entirely.
 func (f *Fixed) UnmarshalJSON(data []byte) error {
-	if len(data) == 4 && data[0] == 'n' && data[1] == 'u' && data[2] == 'l' && data[3] == 'l' {
+	if isJSONNull(data) {
 		*f = 0
 		return nil
 	}
-	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
-		data = data[1 : len(data)-1]
+	data, err := jsonText(data)
+	if err != nil {
+		return err
 	}
 	val, err := NewFixedFromString(unsafe.String(unsafe.SliceData(data), len(data)))
 	if err != nil {
@@ -58,3 +82,107 @@ func (f *Fixed) UnmarshalJSON(data []byte) error {
 	*f = val
 	return nil
 }
+
+// trimJSONSpace removes the whitespace allowed around JSON values.
+func trimJSONSpace(data []byte) []byte {
+	for len(data) > 0 && isJSONSpace(data[0]) {
+		data = data[1:]
+	}
+	for len(data) > 0 && isJSONSpace(data[len(data)-1]) {
+		data = data[:len(data)-1]
+	}
+	return data
+}
+
+func isJSONSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isJSONNull(data []byte) bool {
+	data = trimJSONSpace(data)
+	return len(data) == 4 && data[0] == 'n' && data[1] == 'u' && data[2] == 'l' && data[3] == 'l'
+}
+
+// jsonText returns the text of a raw JSON number or string with surrounding whitespace removed
+// and the quotes and escapes of strings decoded. Only strings containing escapes are copied.
+func jsonText(data []byte) ([]byte, error) {
+	data = trimJSONSpace(data)
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return data, nil
+	}
+	data = data[1 : len(data)-1]
+	if bytes.IndexByte(data, '\\') < 0 {
+		return data, nil
+	}
+	return jsonUnescape(data)
+}
+
+// jsonUnescape decodes the escape sequences of the content of a JSON string.
+// Invalid surrogates are replaced by U+FFFD like encoding/json does.
+func jsonUnescape(s []byte) ([]byte, error) {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b = append(b, s[i])
+			continue
+		}
+		if i++; i == len(s) {
+			return nil, fmt.Errorf("unterminated escape sequence in JSON string")
+		}
+		switch s[i] {
+		case '"', '\\', '/':
+			b = append(b, s[i])
+		case 'b':
+			b = append(b, '\b')
+		case 'f':
+			b = append(b, '\f')
+		case 'n':
+			b = append(b, '\n')
+		case 'r':
+			b = append(b, '\r')
+		case 't':
+			b = append(b, '\t')
+		case 'u':
+			r, ok := jsonHex4(s[i+1:])
+			if !ok {
+				return nil, fmt.Errorf("invalid unicode escape sequence in JSON string: %s", s[i-1:min(i+5, len(s))])
+			}
+			i += 4
+			if utf16.IsSurrogate(r) {
+				r2, ok := rune(0), false
+				if i+2 < len(s) && s[i+1] == '\\' && s[i+2] == 'u' {
+					r2, ok = jsonHex4(s[i+3:])
+				}
+				if r = utf16.DecodeRune(r, r2); ok && r != utf8.RuneError {
+					i += 6
+				}
+			}
+			b = utf8.AppendRune(b, r)
+		default:
+			return nil, fmt.Errorf("invalid escape sequence in JSON string: \\%c", s[i])
+		}
+	}
+	return b, nil
+}
+
+// jsonHex4 parses the four hexadecimal digits of a \u escape sequence.
+func jsonHex4(s []byte) (rune, bool) {
+	if len(s) < 4 {
+		return 0, false
+	}
+	var r rune
+	for _, c := range s[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+		case c >= 'a' && c <= 'f':
+			c -= 'a' - 10
+		case c >= 'A' && c <= 'F':
+			c -= 'A' - 10
+		default:
+			return 0, false
+		}
+		r = r<<4 | rune(c)
+	}
+	return r, true
+}
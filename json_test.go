@@ -63,6 +63,29 @@ func BenchmarkDecimal_MarshalJSON(b *testing.B) {
 	}
 }
 
+func TestDecimal_AppendJSON(t *testing.T) {
+	d := decimal.Decimal{Negative: true, Integer: 123, Fraction: 4500, Digits: 4}
+	got, err := d.AppendJSON([]byte(`{"price":`))
+	if err != nil {
+		t.Fatalf("Decimal.AppendJSON() error = %v", err)
+	}
+	if want := `{"price":-123.4500`; string(got) != want {
+		t.Errorf("Decimal.AppendJSON() = %s, want %s", got, want)
+	}
+	buf := make([]byte, 0, 48)
+	if allocs := testing.AllocsPerRun(100, func() { buf, _ = d.AppendJSON(buf[:0]) }); allocs != 0 {
+		t.Errorf("Decimal.AppendJSON() allocates %v times", allocs)
+	}
+}
+
+func BenchmarkDecimal_AppendJSON(b *testing.B) {
+	d := decimal.Decimal{Integer: 123, Fraction: 123, Digits: 3}
+	buf := make([]byte, 0, 48)
+	for b.Loop() {
+		buf, _ = d.AppendJSON(buf[:0])
+	}
+}
+
 func TestDecimal_UnmarshalJSON(t *testing.T) {
 	sentinel := decimal.Decimal{Integer: 7, Fraction: 5, Digits: 1}
 	tests := []struct {
@@ -78,6 +101,18 @@ func TestDecimal_UnmarshalJSON(t *testing.T) {
 		{"digits", []byte("123.123"), sentinel, decimal.Decimal{Integer: 123, Fraction: 123, Digits: 3}, false},
 		{"quote", []byte(`"123.123"`), sentinel, decimal.Decimal{Integer: 123, Fraction: 123, Digits: 3}, false},
 		{"null", []byte("null"), sentinel, decimal.Decimal{}, false},
+		{"null_whitespace", []byte(" null\n"), sentinel, decimal.Decimal{}, false},
+		{"whitespace", []byte("\t 12.5 \r\n"), sentinel, decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, false},
+		{"quote_whitespace", []byte(` "12.5" `), sentinel, decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, false},
+		{"escape_unicode", []byte(`"\u0031.5"`), sentinel, decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, false},
+		{"escape_unicode_uppercase", []byte(`"\u002D\u0030\u002e5"`), sentinel, decimal.Decimal{Negative: true, Fraction: 5, Digits: 1}, false},
+		{"escape_solidus", []byte(`"1\/2"`), sentinel, sentinel, true},
+		{"escape_newline", []byte(`"1.5\n"`), sentinel, sentinel, true},
+		{"escape_surrogate_pair", []byte(`"1\ud83d\ude00"`), sentinel, sentinel, true},
+		{"escape_invalid", []byte(`"1\x5"`), sentinel, sentinel, true},
+		{"escape_short_unicode", []byte(`"1\u003"`), sentinel, sentinel, true},
+		{"escape_unterminated", []byte(`"1\"`), sentinel, sentinel, true},
+		{"quote_inner_whitespace", []byte(`" 12.5"`), sentinel, sentinel, true},
 		{"overflow_string", []byte(`"18446744073709551616"`), sentinel, sentinel, true},
 		{"bad", []byte("bad"), sentinel, sentinel, true},
 		{"invalid", []byte("123.123.123"), sentinel, sentinel, true},
@@ -152,6 +187,15 @@ func BenchmarkDecimal_UnmarshalJSON(b *testing.B) {
 	}
 }
 
+func TestDecimal_UnmarshalJSON_Allocs(t *testing.T) {
+	var d decimal.Decimal
+	for _, data := range [][]byte{[]byte("123.123"), []byte(`"123.123"`), []byte(" 123.123 ")} {
+		if allocs := testing.AllocsPerRun(100, func() { _ = d.UnmarshalJSON(data) }); allocs != 0 {
+			t.Errorf("Decimal.UnmarshalJSON(%s) allocates %v times", data, allocs)
+		}
+	}
+}
+
 func TestFixed_MarshalJSON(t *testing.T) {
 	tests := []struct {
 		name string
@@ -201,6 +245,21 @@ func BenchmarkFixed_MarshalJSON(b *testing.B) {
 	}
 }
 
+func TestFixed_AppendJSON(t *testing.T) {
+	f := decimal.Fixed(-12345)
+	got, err := f.AppendJSON([]byte(`[`))
+	if err != nil {
+		t.Fatalf("Fixed.AppendJSON() error = %v", err)
+	}
+	if want := `[-123.45`; string(got) != want {
+		t.Errorf("Fixed.AppendJSON() = %s, want %s", got, want)
+	}
+	buf := make([]byte, 0, 16)
+	if allocs := testing.AllocsPerRun(100, func() { buf, _ = f.AppendJSON(buf[:0]) }); allocs != 0 {
+		t.Errorf("Fixed.AppendJSON() allocates %v times", allocs)
+	}
+}
+
 func TestFixed_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -216,6 +275,9 @@ func TestFixed_UnmarshalJSON(t *testing.T) {
 		{"negative", []byte("-123.45"), 0, -12345, false},
 		{"quote", []byte(`"123.45"`), 0, 12345, false},
 		{"null", []byte("null"), 12345, 0, false},
+		{"whitespace", []byte(" 123.45\n"), 0, 12345, false},
+		{"escape_unicode", []byte(`"\u0031\u0032.50"`), 0, 1250, false},
+		{"escape_invalid", []byte(`"\q12"`), 12345, 12345, true},
 		{"truncated", []byte("123.456"), 12345, 12345, true},
 		{"invalid", []byte("123.123.123"), 12345, 12345, true},
 		{"true", []byte("true"), 12345, 12345, true},
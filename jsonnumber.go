@@ -0,0 +1,88 @@
+package decimal
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// NewFromJSONNumber converts a `json.Number` as produced by `json.Decoder.UseNumber` to a decimal value.
+// It accepts the format of `NewFromString` optionally followed by an exponent as in "1.5e3".
+// Values that cannot be represented exactly are rejected.
+func NewFromJSONNumber(n json.Number) (Decimal, error) {
+	return parseExponent(string(n))
+}
+
+// NewFixedFromJSONNumber converts a `json.Number` to a fixed-point value as described by `NewFromJSONNumber`.
+// Fractional digits that cannot be represented and values outside the fixed-point range are rejected.
+func NewFixedFromJSONNumber(n json.Number) (Fixed, error) {
+	d, err := NewFromJSONNumber(n)
+	if err != nil {
+		return 0, err
+	}
+	return fixedFromDecimal(d)
+}
+
+// ConvertJSONNumbers replaces the `json.Number` values in a tree of `map[string]any` and `[]any` values,
+// as produced by decoding into an `any` with `json.Decoder.UseNumber`, by `Decimal` values.
+// Maps and slices are modified in place, the converted value is returned to handle a bare number at the root.
+//
+// If filter is not nil, only numbers for which it returns true are converted.
+// It receives the location of the number as a JSON Pointer (RFC 6901) such as "/items/0/price",
+// the root value has the empty pointer "".
+//
+// Map keys are visited in sorted order and slices by index, so the walk is deterministic.
+// The first number that cannot be converted stops the walk and returns an error naming its location.
+// The input is then left partially converted: numbers visited before the failing one have been replaced in place.
+func ConvertJSONNumbers(v any, filter func(pointer string) bool) (any, error) {
+	return convertJSONNumbers(v, make([]byte, 0, 64), filter)
+}
+
+func convertJSONNumbers(v any, pointer []byte, filter func(string) bool) (any, error) {
+	switch x := v.(type) {
+	case json.Number:
+		if filter != nil && !filter(string(pointer)) {
+			return v, nil
+		}
+		d, err := NewFromJSONNumber(x)
+		if err != nil {
+			return v, fmt.Errorf("json number at %q: %w", pointer, err)
+		}
+		return d, nil
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(x)) {
+			converted, err := convertJSONNumbers(x[k], appendPointerToken(pointer, k), filter)
+			if err != nil {
+				return v, err
+			}
+			x[k] = converted
+		}
+	case []any:
+		for i, e := range x {
+			converted, err := convertJSONNumbers(e, strconv.AppendInt(append(pointer, '/'), int64(i), 10), filter)
+			if err != nil {
+				return v, err
+			}
+			x[i] = converted
+		}
+	}
+	return v, nil
+}
+
+// appendPointerToken appends a reference token to a JSON Pointer, escaping "~" and "/".
+func appendPointerToken(pointer []byte, token string) []byte {
+	pointer = append(pointer, '/')
+	for i := 0; i < len(token); i++ {
+		switch token[i] {
+		case '~':
+			pointer = append(pointer, "~0"...)
+		case '/':
+			pointer = append(pointer, "~1"...)
+		default:
+			pointer = append(pointer, token[i])
+		}
+	}
+	return pointer
+}
@@ -0,0 +1,170 @@
+package decimal_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestNewFromJSONNumber(t *testing.T) {
+	tests := []struct {
+		n       json.Number
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"0", decimal.Decimal{}, false},
+		{"-12.50", decimal.Decimal{Negative: true, Integer: 12, Fraction: 50, Digits: 2}, false},
+		{"1e5", decimal.Decimal{Integer: 100000}, false},
+		{"1.5E-3", decimal.Decimal{Fraction: 15, Digits: 4}, false},
+		{"-2.5e+1", decimal.Decimal{Negative: true, Integer: 25}, false},
+		{"18446744073709551615", decimal.Decimal{Integer: 18446744073709551615}, false},
+		{"18446744073709551616", decimal.Decimal{}, true},
+		{"1e-20", decimal.Decimal{}, true},
+		{"100e-20", decimal.Decimal{Fraction: 10, Digits: 19}, false},
+		{"-12345678901234567890e-20", decimal.Decimal{Negative: true, Fraction: 1234567890123456789, Digits: 19}, false},
+		{"1e-9223372036854775808", decimal.Decimal{}, true},
+		{"1e9223372036854775807", decimal.Decimal{}, true},
+		{"-1e-99999999999999999999", decimal.Decimal{}, true},
+		{"1e99999999999999999999", decimal.Decimal{}, true},
+		{"1e-41", decimal.Decimal{}, true},
+		{"1e+41", decimal.Decimal{}, true},
+		{"", decimal.Decimal{}, true},
+		{" 1", decimal.Decimal{}, true},
+		{"abc", decimal.Decimal{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.n), func(t *testing.T) {
+			got, err := decimal.NewFromJSONNumber(tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFromJSONNumber(%q) error = %v, wantErr %v", tt.n, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewFromJSONNumber(%q) = %#v, want %#v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFixedFromJSONNumber(t *testing.T) {
+	tests := []struct {
+		n       json.Number
+		want    decimal.Fixed
+		wantErr bool
+	}{
+		{"12.5", 1250, false},
+		{"-1.25e1", -1250, false},
+		{"125E-4", 0, true},
+		{"21474836.48", 0, true},
+		{"1e-9223372036854775808", 0, true},
+		{"-1e9223372036854775807", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := decimal.NewFixedFromJSONNumber(tt.n)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("NewFixedFromJSONNumber(%q) = %d, %v, want %d, error %v", tt.n, int32(got), err, int32(tt.want), tt.wantErr)
+		}
+	}
+}
+
+func decodeUseNumber(t *testing.T, s string) any {
+	t.Helper()
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		t.Fatalf("Decode(%s) error = %v", s, err)
+	}
+	return v
+}
+
+func TestConvertJSONNumbers(t *testing.T) {
+	const doc = `{"id": 17, "items": [{"price": 12.50, "qty": 2}, {"price": 1e2, "qty": 1}], "a/b~c": 0.5, "name": "x"}`
+	d := func(i, f uint64, digits uint8) decimal.Decimal {
+		return decimal.Decimal{Integer: i, Fraction: f, Digits: digits}
+	}
+
+	got, err := decimal.ConvertJSONNumbers(decodeUseNumber(t, doc), nil)
+	if err != nil {
+		t.Fatalf("ConvertJSONNumbers() error = %v", err)
+	}
+	want := map[string]any{
+		"id": d(17, 0, 0),
+		"items": []any{
+			map[string]any{"price": d(12, 50, 2), "qty": d(2, 0, 0)},
+			map[string]any{"price": d(100, 0, 0), "qty": d(1, 0, 0)},
+		},
+		"a/b~c": d(0, 5, 1),
+		"name":  "x",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConvertJSONNumbers() = %#v, want %#v", got, want)
+	}
+
+	var pointers []string
+	got, err = decimal.ConvertJSONNumbers(decodeUseNumber(t, doc), func(pointer string) bool {
+		pointers = append(pointers, pointer)
+		return strings.HasSuffix(pointer, "/price") || pointer == "/a~1b~0c"
+	})
+	if err != nil {
+		t.Fatalf("ConvertJSONNumbers() error = %v", err)
+	}
+	want = map[string]any{
+		"id": json.Number("17"),
+		"items": []any{
+			map[string]any{"price": d(12, 50, 2), "qty": json.Number("2")},
+			map[string]any{"price": d(100, 0, 0), "qty": json.Number("1")},
+		},
+		"a/b~c": d(0, 5, 1),
+		"name":  "x",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConvertJSONNumbers() with filter = %#v, want %#v", got, want)
+	}
+	if len(pointers) != 6 {
+		t.Errorf("filter called for %v, want 6 numbers", pointers)
+	}
+}
+
+func TestConvertJSONNumbers_Root(t *testing.T) {
+	got, err := decimal.ConvertJSONNumbers(json.Number("1.5"), nil)
+	if err != nil || got != (decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}) {
+		t.Errorf("ConvertJSONNumbers(1.5) = %#v, %v", got, err)
+	}
+	if got, err := decimal.ConvertJSONNumbers(json.Number("1.5"), func(string) bool { return false }); err != nil || got != json.Number("1.5") {
+		t.Errorf("ConvertJSONNumbers(1.5) with filter = %#v, %v", got, err)
+	}
+	if got, err := decimal.ConvertJSONNumbers("text", nil); err != nil || got != "text" {
+		t.Errorf("ConvertJSONNumbers(text) = %#v, %v", got, err)
+	}
+}
+
+func TestConvertJSONNumbers_Error(t *testing.T) {
+	_, err := decimal.ConvertJSONNumbers(decodeUseNumber(t, `{"items": [1, 1e400]}`), nil)
+	if err == nil || !strings.Contains(err.Error(), `"/items/1"`) {
+		t.Errorf("ConvertJSONNumbers() error = %v, want error naming /items/1", err)
+	}
+	_, err = decimal.ConvertJSONNumbers(decodeUseNumber(t, `{"a": {"b": 1e-9223372036854775808}}`), nil)
+	if err == nil || !strings.Contains(err.Error(), `"/a/b"`) {
+		t.Errorf("ConvertJSONNumbers() error = %v, want error naming /a/b", err)
+	}
+}
+
+func TestConvertJSONNumbers_ErrorOrder(t *testing.T) {
+	// Keys are walked in sorted order, so the same number is reported and the same prefix is converted on every run.
+	for range 20 {
+		doc := decodeUseNumber(t, `{"z": 1e400, "b": 2, "m": 1e-400, "a": 1}`).(map[string]any)
+		_, err := decimal.ConvertJSONNumbers(doc, nil)
+		if err == nil || !strings.Contains(err.Error(), `"/m"`) {
+			t.Fatalf("ConvertJSONNumbers() error = %v, want error naming /m", err)
+		}
+		if _, ok := doc["b"].(decimal.Decimal); !ok {
+			t.Errorf("ConvertJSONNumbers() left /b = %#v, want converted", doc["b"])
+		}
+		if _, ok := doc["z"].(json.Number); !ok {
+			t.Errorf("ConvertJSONNumbers() converted /z = %#v after the error", doc["z"])
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package decimal
+
+// JSONOptions configures alternative JSON encodings of decimal and fixed-point values.
+// The zero value produces the same output as `MarshalJSON`: a bare JSON number with the digits of the value.
+// Under the goexperiment.jsonv2 build, `Marshalers` and `Unmarshalers` apply the options to encoding/json/v2.
+type JSONOptions struct {
+	// String encodes all values as JSON strings such as "12.50".
+	String bool
+	// Safe encodes values as JSON strings if a JavaScript number cannot represent them exactly,
+	// i.e. integers beyond 2^53 and values with more than 15 significant digits. Other values remain numbers.
+	Safe bool
+	// FixedScale rounds all values to exactly Scale fractional digits, half away from zero as `Round` does.
+	FixedScale bool
+	// Scale is the number of fractional digits used with FixedScale, limited to 19.
+	Scale uint8
+}
+
+// AppendDecimal appends the JSON encoding of the decimal value according to the options to b and returns the extended buffer.
+func (o JSONOptions) AppendDecimal(b []byte, d Decimal) []byte {
+	if o.FixedScale {
+		d = d.Round(o.Scale)
+	}
+	quote := o.String || (o.Safe && !d.jsSafe())
+	if quote {
+		b = append(b, '"')
+	}
+	var arr [48]byte
+	pos := d.text(&arr)
+	b = append(b, arr[pos:]...)
+	if quote {
+		b = append(b, '"')
+	}
+	return b
+}
+
+// AppendFixed appends the JSON encoding of the fixed-point value according to the options to b and returns the extended buffer.
+// Fixed-point values are always safe for JavaScript, so only String and FixedScale have an effect.
+func (o JSONOptions) AppendFixed(b []byte, f Fixed) []byte {
+	return o.AppendDecimal(b, f.Decimal())
+}
+
+// jsSafe reports whether a JavaScript number (an IEEE 754 double) represents the value exactly.
+// This holds for integers up to 2^53 and for values with at most 15 significant digits.
+func (d Decimal) jsSafe() bool {
+	d = d.Truncate()
+	if d.Digits == 0 {
+		return d.Integer <= 1<<53
+	}
+	n := int(d.Digits)
+	if d.Integer == 0 {
+		n = countDigits(d.Fraction)
+	} else {
+		n += countDigits(d.Integer)
+	}
+	return n <= 15
+}
+
+// countDigits returns the number of decimal digits of x, at least 1.
+func countDigits(x uint64) int {
+	n := 1
+	for n < len(pow10) && x >= pow10[n] {
+		n++
+	}
+	return n
+}
+
+// JSONString is a decimal value that is encoded as a JSON string such as "12.50".
+// Convert values with `JSONString(d)` when encoding and decode into `(*JSONString)(&d)`.
+// Decoding accepts JSON numbers and strings like `Decimal.UnmarshalJSON`.
+type JSONString Decimal
+
+// MarshalJSON encodes the decimal value as a JSON string.
+func (s JSONString) MarshalJSON() ([]byte, error) {
+	return JSONOptions{String: true}.AppendDecimal(nil, Decimal(s)), nil
+}
+
+// UnmarshalJSON decodes a JSON number or string as described by `Decimal.UnmarshalJSON`.
+func (s *JSONString) UnmarshalJSON(data []byte) error {
+	return (*Decimal)(s).UnmarshalJSON(data)
+}
+
+// JSONSafe is a decimal value that is encoded as a JSON number if a JavaScript number can represent it exactly
+// and as a JSON string otherwise, see `JSONOptions.Safe`.
+// Convert values with `JSONSafe(d)` when encoding and decode into `(*JSONSafe)(&d)`.
+type JSONSafe Decimal
+
+// MarshalJSON encodes the decimal value as a JSON number or string.
+func (s JSONSafe) MarshalJSON() ([]byte, error) {
+	return JSONOptions{Safe: true}.AppendDecimal(nil, Decimal(s)), nil
+}
+
+// UnmarshalJSON decodes a JSON number or string as described by `Decimal.UnmarshalJSON`.
+func (s *JSONSafe) UnmarshalJSON(data []byte) error {
+	return (*Decimal)(s).UnmarshalJSON(data)
+}
+
+// FixedJSONString is a fixed-point value that is encoded as a JSON string such as "12.50".
+// Convert values with `FixedJSONString(f)` when encoding and decode into `(*FixedJSONString)(&f)`.
+type FixedJSONString Fixed
+
+// MarshalJSON encodes the fixed-point value as a JSON string.
+func (s FixedJSONString) MarshalJSON() ([]byte, error) {
+	return JSONOptions{String: true}.AppendFixed(nil, Fixed(s)), nil
+}
+
+// UnmarshalJSON decodes a JSON number or string as described by `Fixed.UnmarshalJSON`.
+func (s *FixedJSONString) UnmarshalJSON(data []byte) error {
+	return (*Fixed)(s).UnmarshalJSON(data)
+}
+
+// JSONScaled is a decimal value that is encoded as a JSON number with exactly Scale fractional digits,
+// see `JSONOptions.FixedScale`. Set Scale before decoding; decoded values are rounded to it.
+// Decoding accepts JSON numbers and strings like `Decimal.UnmarshalJSON`.
+type JSONScaled struct {
+	Value Decimal
+	// Scale is the number of fractional digits, limited to 19.
+	Scale uint8
+}
+
+// MarshalJSON encodes the decimal value as a JSON number rounded to Scale fractional digits.
+func (s JSONScaled) MarshalJSON() ([]byte, error) {
+	return JSONOptions{FixedScale: true, Scale: s.Scale}.AppendDecimal(nil, s.Value), nil
+}
+
+// UnmarshalJSON decodes a JSON number or string as described by `Decimal.UnmarshalJSON` and rounds it to Scale fractional digits.
+func (s *JSONScaled) UnmarshalJSON(data []byte) error {
+	var d Decimal
+	if err := d.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	s.Value = d.Round(s.Scale)
+	return nil
+}
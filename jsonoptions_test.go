@@ -0,0 +1,119 @@
+package decimal_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestJSONOptions_AppendDecimal(t *testing.T) {
+	tests := []struct {
+		name string
+		opts decimal.JSONOptions
+		d    decimal.Decimal
+		want string
+	}{
+		{"default", decimal.JSONOptions{}, decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}, `12.50`},
+		{"string", decimal.JSONOptions{String: true}, decimal.Decimal{Negative: true, Integer: 12, Fraction: 50, Digits: 2}, `"-12.50"`},
+		{"safe_small", decimal.JSONOptions{Safe: true}, decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}, `12.50`},
+		{"safe_max_integer", decimal.JSONOptions{Safe: true}, decimal.Decimal{Integer: 1 << 53}, `9007199254740992`},
+		{"safe_large_integer", decimal.JSONOptions{Safe: true}, decimal.Decimal{Integer: 1<<53 + 1}, `"9007199254740993"`},
+		{"safe_large_integer_trailing_zeros", decimal.JSONOptions{Safe: true}, decimal.Decimal{Integer: 1 << 54, Digits: 2}, `"18014398509481984.00"`},
+		{"safe_15_digits", decimal.JSONOptions{Safe: true}, decimal.Decimal{Integer: 1234567, Fraction: 12345678, Digits: 8}, `1234567.12345678`},
+		{"safe_16_digits", decimal.JSONOptions{Safe: true}, decimal.Decimal{Integer: 1234567, Fraction: 123456789, Digits: 9}, `"1234567.123456789"`},
+		{"safe_long_fraction", decimal.JSONOptions{Safe: true}, decimal.Decimal{Fraction: 1000000000000000001, Digits: 19}, `"0.1000000000000000001"`},
+		{"safe_small_fraction", decimal.JSONOptions{Safe: true}, decimal.Decimal{Fraction: 1, Digits: 19}, `0.0000000000000000001`},
+		{"safe_negative", decimal.JSONOptions{Safe: true}, decimal.Decimal{Negative: true, Integer: 1<<53 + 1}, `"-9007199254740993"`},
+		{"fixed_scale_pad", decimal.JSONOptions{FixedScale: true, Scale: 2}, decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, `12.50`},
+		{"fixed_scale_round", decimal.JSONOptions{FixedScale: true, Scale: 2}, decimal.Decimal{Integer: 12, Fraction: 345, Digits: 3}, `12.35`},
+		{"fixed_scale_zero", decimal.JSONOptions{FixedScale: true}, decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, `13`},
+		{"fixed_scale_string", decimal.JSONOptions{String: true, FixedScale: true, Scale: 2}, decimal.Decimal{Integer: 12}, `"12.00"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.AppendDecimal([]byte("x"), tt.d); string(got) != "x"+tt.want {
+				t.Errorf("AppendDecimal() = %s, want x%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONOptions_AppendFixed(t *testing.T) {
+	if got := (decimal.JSONOptions{String: true}).AppendFixed(nil, -1250); string(got) != `"-12.50"` {
+		t.Errorf("AppendFixed() = %s, want \"-12.50\"", got)
+	}
+	if got := (decimal.JSONOptions{FixedScale: true, Scale: 4}).AppendFixed(nil, 5); string(got) != `0.0500` {
+		t.Errorf("AppendFixed() = %s, want 0.0500", got)
+	}
+	if got := (decimal.JSONOptions{Safe: true}).AppendFixed(nil, 2147483647); string(got) != `21474836.47` {
+		t.Errorf("AppendFixed() = %s, want 21474836.47", got)
+	}
+}
+
+func TestJSONString(t *testing.T) {
+	type S struct {
+		String decimal.JSONString      `json:"string"`
+		Safe   decimal.JSONSafe        `json:"safe"`
+		Fixed  decimal.FixedJSONString `json:"fixed"`
+	}
+	s := S{
+		String: decimal.JSONString{Integer: 12, Fraction: 50, Digits: 2},
+		Safe:   decimal.JSONSafe{Fraction: 1000000000000000001, Digits: 19},
+		Fixed:  -5,
+	}
+	want := `{"string":"12.50","safe":"0.1000000000000000001","fixed":"-0.05"}`
+	got, err := json.Marshal(s)
+	if err != nil || string(got) != want {
+		t.Fatalf("json.Marshal() = %s, %v, want %s", got, err, want)
+	}
+	var back S
+	if err := json.Unmarshal(got, &back); err != nil || back != s {
+		t.Errorf("json.Unmarshal() = %#v, %v, want %#v", back, err, s)
+	}
+	if err := json.Unmarshal([]byte(`{"string":1.5,"safe":2,"fixed":3}`), &back); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if back.String != (decimal.JSONString{Integer: 1, Fraction: 5, Digits: 1}) || back.Safe != (decimal.JSONSafe{Integer: 2}) || back.Fixed != 300 {
+		t.Errorf("json.Unmarshal() = %#v", back)
+	}
+}
+
+func TestJSONScaled(t *testing.T) {
+	type S struct {
+		Price decimal.JSONScaled `json:"price"`
+	}
+	s := S{Price: decimal.JSONScaled{Value: decimal.Decimal{Integer: 12, Fraction: 345, Digits: 3}, Scale: 2}}
+	got, err := json.Marshal(s)
+	if want := `{"price":12.35}`; err != nil || string(got) != want {
+		t.Fatalf("json.Marshal() = %s, %v, want %s", got, err, want)
+	}
+	tests := []struct {
+		data string
+		want decimal.Decimal
+	}{
+		{`{"price":12.35}`, decimal.Decimal{Integer: 12, Fraction: 35, Digits: 2}},
+		{`{"price":12.5}`, decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}},
+		{`{"price":"-0.005"}`, decimal.Decimal{Negative: true, Fraction: 1, Digits: 2}},
+		{`{"price":7}`, decimal.Decimal{Integer: 7, Digits: 2}},
+	}
+	for _, tt := range tests {
+		back := S{Price: decimal.JSONScaled{Scale: 2}}
+		if err := json.Unmarshal([]byte(tt.data), &back); err != nil || back.Price.Value != tt.want || back.Price.Scale != 2 {
+			t.Errorf("json.Unmarshal(%s) = %#v, %v, want %#v", tt.data, back.Price, err, tt.want)
+		}
+	}
+	var back S
+	if err := json.Unmarshal([]byte(`{"price":"x"}`), &back); err == nil {
+		t.Errorf("json.Unmarshal() = %#v, want error", back)
+	}
+}
+
+func BenchmarkJSONOptions_AppendDecimal(b *testing.B) {
+	opts := decimal.JSONOptions{Safe: true}
+	d := decimal.Decimal{Integer: 1234567, Fraction: 123456789, Digits: 9}
+	buf := make([]byte, 0, 64)
+	for b.Loop() {
+		buf = opts.AppendDecimal(buf[:0], d)
+	}
+}
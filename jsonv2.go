@@ -4,6 +4,7 @@ package decimal
 
 import (
 	"encoding/json/jsontext"
+	"encoding/json/v2"
 	"fmt"
 	"unsafe"
 )
@@ -26,7 +27,9 @@ func (d *Decimal) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
 		*d = Zero()
 		return nil
 	case jsontext.KindString:
-		val = val[1 : len(val)-1] // strip quotes
+		if val, err = jsonText(val); err != nil {
+			return err
+		}
 		fallthrough
 	case jsontext.KindNumber:
 		parsed, err := NewFromString(unsafe.String(unsafe.SliceData(val), len(val)))
@@ -58,7 +61,9 @@ func (f *Fixed) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
 		*f = 0
 		return nil
 	case jsontext.KindString:
-		val = val[1 : len(val)-1] // strip quotes
+		if val, err = jsonText(val); err != nil {
+			return err
+		}
 		fallthrough
 	case jsontext.KindNumber:
 		parsed, err := NewFixedFromString(unsafe.String(unsafe.SliceData(val), len(val)))
@@ -71,3 +76,98 @@ func (f *Fixed) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
 		return fmt.Errorf("decimal: unsupported JSON kind: %v", val.Kind())
 	}
 }
+
+// MarshalJSONTo implements encoding/json/v2.MarshalerTo. Null values are encoded as JSON `null`.
+func (n NullDecimal) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return n.Decimal.MarshalJSONTo(enc)
+}
+
+// UnmarshalJSONFrom implements encoding/json/v2.UnmarshalerFrom. JSON `null` is decoded as a null value.
+func (n *NullDecimal) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == jsontext.KindNull {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		n.Decimal, n.Valid = Zero(), false
+		return nil
+	}
+	if err := n.Decimal.UnmarshalJSONFrom(dec); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSONTo implements encoding/json/v2.MarshalerTo. Null values are encoded as JSON `null`.
+func (n NullFixed) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.Valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return n.Fixed.MarshalJSONTo(enc)
+}
+
+// UnmarshalJSONFrom implements encoding/json/v2.UnmarshalerFrom. JSON `null` is decoded as a null value.
+func (n *NullFixed) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == jsontext.KindNull {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		n.Fixed, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Fixed.UnmarshalJSONFrom(dec); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Marshalers returns encoding/json/v2 marshalers that encode `Decimal` and `Fixed` values according to the options.
+// Use them with json.WithMarshalers.
+func (o JSONOptions) Marshalers() *json.Marshalers {
+	return json.JoinMarshalers(
+		json.MarshalToFunc(func(enc *jsontext.Encoder, d Decimal) error {
+			var arr [50]byte
+			return enc.WriteValue(o.AppendDecimal(arr[:0], d))
+		}),
+		json.MarshalToFunc(func(enc *jsontext.Encoder, f Fixed) error {
+			var arr [50]byte
+			return enc.WriteValue(o.AppendFixed(arr[:0], f))
+		}),
+	)
+}
+
+// Unmarshalers returns encoding/json/v2 unmarshalers that decode `Decimal` and `Fixed` values from JSON numbers and strings
+// like their UnmarshalJSONFrom methods. With FixedScale, decoded values are rounded to Scale fractional digits.
+// Use them with json.WithUnmarshalers.
+func (o JSONOptions) Unmarshalers() *json.Unmarshalers {
+	return json.JoinUnmarshalers(
+		json.UnmarshalFromFunc(func(dec *jsontext.Decoder, d *Decimal) error {
+			if err := d.UnmarshalJSONFrom(dec); err != nil {
+				return err
+			}
+			if o.FixedScale {
+				*d = d.Round(o.Scale)
+			}
+			return nil
+		}),
+		json.UnmarshalFromFunc(func(dec *jsontext.Decoder, f *Fixed) error {
+			if !o.FixedScale {
+				return f.UnmarshalJSONFrom(dec)
+			}
+			var d Decimal
+			if err := d.UnmarshalJSONFrom(dec); err != nil {
+				return err
+			}
+			val, err := fixedFromDecimal(d.Round(o.Scale))
+			if err != nil {
+				return err
+			}
+			*f = val
+			return nil
+		}),
+	)
+}
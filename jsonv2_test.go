@@ -73,6 +73,10 @@ func TestDecimal_UnmarshalJSONFrom(t *testing.T) {
 		{"quote_negative", `"-0.5"`, sentinel, decimal.Decimal{Fraction: 5, Digits: 1, Negative: true}, false},
 		{"quote_maxuint64", `"18446744073709551615"`, sentinel, decimal.Decimal{Integer: ^uint64(0)}, false},
 		{"quote_overflow", `"18446744073709551616"`, sentinel, sentinel, true},
+		{"whitespace", " 12.5\n", sentinel, decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, false},
+		{"escape_unicode", `"\u0031.5"`, sentinel, decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, false},
+		{"escape_newline", `"1.5\n"`, sentinel, sentinel, true},
+		{"quote_inner_whitespace", `" 12.5"`, sentinel, sentinel, true},
 		{"bad", "bad", sentinel, sentinel, true},
 		{"true", "true", sentinel, sentinel, true},
 		{"false", "false", sentinel, sentinel, true},
@@ -207,6 +211,8 @@ func TestFixed_UnmarshalJSONFrom(t *testing.T) {
 		{"negative_digits", "-123.45", 0, -12345, false},
 		{"null", "null", 12345, 0, false},
 		{"quote", `"123.45"`, 0, 12345, false},
+		{"escape_unicode", `"\u0031\u0032.50"`, 0, 1250, false},
+		{"escape_tab", `"\t12.50"`, 12345, 12345, true},
 		{"bad", "bad", 12345, 12345, true},
 		{"truncated", "123.456", 12345, 12345, true},
 		{"true", "true", 12345, 12345, true},
@@ -275,3 +281,81 @@ func BenchmarkFixed_UnmarshalJSONFrom(b *testing.B) {
 		r.Reset(data)
 	}
 }
+
+func TestNullDecimal_JSONv2(t *testing.T) {
+	type S struct {
+		D decimal.NullDecimal `json:"d"`
+		F decimal.NullFixed   `json:"f"`
+	}
+	tests := []struct {
+		name string
+		s    S
+		json string
+	}{
+		{"null", S{}, `{"d":null,"f":null}`},
+		{"zero", S{decimal.NullDecimal{Valid: true}, decimal.NullFixed{Valid: true}}, `{"d":0,"f":0.00}`},
+		{"value", S{decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, Valid: true}, decimal.NullFixed{Fixed: -250, Valid: true}}, `{"d":1.5,"f":-2.50}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.s)
+			if err != nil || string(got) != tt.json {
+				t.Errorf("json.Marshal() = %s, %v, want %s", got, err, tt.json)
+			}
+			back := S{decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 9}, Valid: !tt.s.D.Valid}, decimal.NullFixed{Fixed: 9, Valid: !tt.s.F.Valid}}
+			if err := json.Unmarshal([]byte(tt.json), &back); err != nil || back != tt.s {
+				t.Errorf("json.Unmarshal(%s) = %#v, %v, want %#v", tt.json, back, err, tt.s)
+			}
+		})
+	}
+}
+
+func TestJSONOptions_Marshalers(t *testing.T) {
+	type S struct {
+		D decimal.Decimal `json:"d"`
+		F decimal.Fixed   `json:"f"`
+	}
+	s := S{D: decimal.Decimal{Integer: 1<<53 + 1}, F: 1250}
+	tests := []struct {
+		name string
+		opts decimal.JSONOptions
+		want string
+	}{
+		{"default", decimal.JSONOptions{}, `{"d":9007199254740993,"f":12.50}`},
+		{"string", decimal.JSONOptions{String: true}, `{"d":"9007199254740993","f":"12.50"}`},
+		{"safe", decimal.JSONOptions{Safe: true}, `{"d":"9007199254740993","f":12.50}`},
+		{"fixed_scale", decimal.JSONOptions{FixedScale: true, Scale: 1}, `{"d":9007199254740993.0,"f":12.5}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(s, json.WithMarshalers(tt.opts.Marshalers()))
+			if err != nil || string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONOptions_Unmarshalers(t *testing.T) {
+	type S struct {
+		D decimal.Decimal `json:"d"`
+		F decimal.Fixed   `json:"f"`
+	}
+	var s S
+	opts := decimal.JSONOptions{FixedScale: true, Scale: 1}
+	if err := json.Unmarshal([]byte(`{"d":"1.25","f":1.25}`), &s, json.WithUnmarshalers(opts.Unmarshalers())); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if want := (S{D: decimal.Decimal{Integer: 1, Fraction: 3, Digits: 1}, F: 130}); s != want {
+		t.Errorf("json.Unmarshal() = %#v, want %#v", s, want)
+	}
+	if err := json.Unmarshal([]byte(`{"d":"1.25","f":"1.25"}`), &s, json.WithUnmarshalers(decimal.JSONOptions{}.Unmarshalers())); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if want := (S{D: decimal.Decimal{Integer: 1, Fraction: 25, Digits: 2}, F: 125}); s != want {
+		t.Errorf("json.Unmarshal() = %#v, want %#v", s, want)
+	}
+	if err := json.Unmarshal([]byte(`{"f":1.255}`), &s, json.WithUnmarshalers(decimal.JSONOptions{}.Unmarshalers())); err == nil {
+		t.Error("json.Unmarshal() expected error for sub-hundredth Fixed")
+	}
+}
@@ -0,0 +1,41 @@
+package decimal
+
+import "hash/maphash"
+
+// Key is a comparable representation of a decimal value for use as a map key.
+// Values that are `Equal` have identical keys regardless of their scale, so 0.1 and 0.10 share the same key.
+// The zero value is the key of zero.
+type Key struct {
+	d Decimal
+}
+
+// Key returns the map key of the decimal value.
+func (d Decimal) Key() Key {
+	return Key{d.Canonical()}
+}
+
+// Key returns the map key of the fixed-point value, which is identical to the key of the equal decimal value.
+func (f Fixed) Key() Key {
+	return f.Decimal().Key()
+}
+
+// Decimal returns the canonical decimal value of the key.
+func (k Key) Decimal() Decimal {
+	return k.d
+}
+
+// String converts the key into the string representation of its canonical decimal value.
+func (k Key) String() string {
+	return k.d.String()
+}
+
+// Hash returns a hash of the decimal value that is consistent with `Equal`:
+// values that are equal have the same hash for the same seed.
+func (d Decimal) Hash(seed maphash.Seed) uint64 {
+	return maphash.Comparable(seed, d.Canonical())
+}
+
+// Hash returns a hash of the fixed-point value that is identical to the hash of the equal decimal value.
+func (f Fixed) Hash(seed maphash.Seed) uint64 {
+	return f.Decimal().Hash(seed)
+}
@@ -0,0 +1,93 @@
+package decimal_test
+
+import (
+	"hash/maphash"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestDecimal_Canonical(t *testing.T) {
+	tests := []struct {
+		name string
+		d    decimal.Decimal
+		want decimal.Decimal
+	}{
+		{"zero", decimal.Decimal{}, decimal.Decimal{}},
+		{"zero_with_digits", decimal.Decimal{Digits: 5}, decimal.Decimal{}},
+		{"integer", decimal.Decimal{Negative: true, Integer: 12}, decimal.Decimal{Negative: true, Integer: 12}},
+		{"trailing_zeros", decimal.Decimal{Integer: 12, Fraction: 3400, Digits: 4}, decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}},
+		{"integral_with_digits", decimal.Decimal{Negative: true, Integer: 7, Digits: 19}, decimal.Decimal{Negative: true, Integer: 7}},
+		{"already_canonical", decimal.Decimal{Fraction: 1, Digits: 19}, decimal.Decimal{Fraction: 1, Digits: 19}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Canonical(); got != tt.want {
+				t.Errorf("Canonical() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Key(t *testing.T) {
+	groups := map[decimal.Key]int{}
+	for _, d := range []decimal.Decimal{
+		{Fraction: 1, Digits: 1},
+		{Fraction: 10, Digits: 2},
+		{Fraction: 1000000000000000000, Digits: 19},
+		{Integer: 2},
+		{Integer: 2, Digits: 3},
+		{Negative: true, Integer: 2},
+		{},
+		{Digits: 4},
+	} {
+		groups[d.Key()]++
+	}
+	groups[decimal.Fixed(200).Key()]++
+	want := map[string]int{"0.1": 3, "2": 3, "-2": 1, "0": 2}
+	if len(groups) != len(want) {
+		t.Fatalf("got %d groups %v, want %d", len(groups), groups, len(want))
+	}
+	for k, n := range groups {
+		if want[k.String()] != n {
+			t.Errorf("group %s has %d values, want %d", k, n, want[k.String()])
+		}
+	}
+	if got := (decimal.Decimal{Integer: 1, Fraction: 50, Digits: 2}).Key().Decimal(); got != (decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}) {
+		t.Errorf("Key().Decimal() = %#v, want canonical value", got)
+	}
+	if (decimal.Key{}) != decimal.Zero().Key() {
+		t.Errorf("zero Key is not the key of zero")
+	}
+}
+
+func TestDecimal_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	equal := [][]decimal.Decimal{
+		{{Fraction: 1, Digits: 1}, {Fraction: 10, Digits: 2}, {Fraction: 1000000000000000000, Digits: 19}},
+		{{}, {Digits: 7}},
+		{{Negative: true, Integer: 12, Fraction: 5, Digits: 1}, {Negative: true, Integer: 12, Fraction: 50, Digits: 2}},
+	}
+	for _, values := range equal {
+		want := values[0].Hash(seed)
+		for _, d := range values[1:] {
+			if got := d.Hash(seed); got != want {
+				t.Errorf("Hash(%#v) = %x, want %x", d, got, want)
+			}
+		}
+	}
+	if a, b := (decimal.Decimal{Integer: 12}).Hash(seed), (decimal.Decimal{Negative: true, Integer: 12}).Hash(seed); a == b {
+		t.Errorf("Hash(12) == Hash(-12) = %x", a)
+	}
+	if got, want := decimal.Fixed(-1250).Hash(seed), (decimal.Decimal{Negative: true, Integer: 12, Fraction: 5, Digits: 1}).Hash(seed); got != want {
+		t.Errorf("Fixed.Hash() = %x, want %x", got, want)
+	}
+}
+
+func BenchmarkDecimal_Hash(b *testing.B) {
+	seed := maphash.MakeSeed()
+	d := decimal.Decimal{Integer: 123, Fraction: 45600, Digits: 5}
+	for b.Loop() {
+		_ = d.Hash(seed)
+	}
+}
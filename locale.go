@@ -0,0 +1,209 @@
+package decimal
+
+//go:generate go run ./internal/cldrgen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Locale describes how numbers are written in a specific region.
+// The zero value describes the plain format used by `NewFromString`: a dot as decimal separator, no grouping and "-" as minus sign.
+type Locale struct {
+	// Decimal is the decimal separator such as "." or ",". An empty string is treated as ".".
+	Decimal string
+	// Group is the separator placed between groups of integer digits such as "," or "'". An empty string disables grouping.
+	Group string
+	// GroupAlternates lists further group separators accepted when parsing, such as "'" for "’" or " " for a no-break space.
+	// A number must use a single separator throughout, formatting always uses Group.
+	GroupAlternates []string
+	// Grouping lists the group sizes starting at the decimal separator.
+	// The last size repeats for all further groups, so {3} yields 1,234,567 while {3, 2} yields 12,34,567.
+	// An empty list is treated as {3}.
+	Grouping []uint8
+	// Minus lists the accepted forms of the minus sign such as "-" or "−".
+	// The first entry is used for formatting. An empty list is treated as {"-"}.
+	Minus []string
+	// CurrencyPlacement is the placement of the currency symbol used by `CurrencyFormatter`.
+	CurrencyPlacement SymbolPlacement
+}
+
+// LookupLocale returns the bundled locale data for a BCP 47 language tag such as "de-CH".
+// Tags are matched case-insensitively and "_" is accepted in place of "-".
+// A tag consisting of just a language such as "de" selects the primary region of that language.
+// The data is derived from the Unicode CLDR for a set of common locales.
+func LookupLocale(tag string) (Locale, bool) {
+	lang, region, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	lang = strings.ToLower(lang)
+	if region == "" {
+		region = localeDefaults[lang]
+	} else {
+		region = lang + "-" + strings.ToUpper(region)
+	}
+	loc, ok := locales[region]
+	if !ok {
+		return Locale{}, false
+	}
+	loc.GroupAlternates = slices.Clone(loc.GroupAlternates)
+	loc.Grouping = slices.Clone(loc.Grouping)
+	loc.Minus = slices.Clone(loc.Minus)
+	return loc, true
+}
+
+// CurrencyFormatter returns a `Formatter` for currency amounts with two fractional digits
+// and the given symbol placed according to the locale.
+func (l Locale) CurrencyFormatter(symbol string) Formatter {
+	return Formatter{
+		Locale:          l,
+		MinFraction:     2,
+		MaxFraction:     2,
+		Symbol:          symbol,
+		SymbolPlacement: l.CurrencyPlacement,
+	}
+}
+
+func (l Locale) decimal() string {
+	if l.Decimal == "" {
+		return "."
+	}
+	return l.Decimal
+}
+
+// groupSize returns the size of the i-th group counted from the decimal separator.
+func (l Locale) groupSize(i int) int {
+	if len(l.Grouping) == 0 {
+		return 3
+	}
+	size := int(l.Grouping[min(i, len(l.Grouping)-1)])
+	if size == 0 {
+		return 3
+	}
+	return size
+}
+
+func (l Locale) minus() string {
+	if len(l.Minus) == 0 {
+		return "-"
+	}
+	return l.Minus[0]
+}
+
+// trimMinus removes a leading minus sign in any of the accepted forms.
+func (l Locale) trimMinus(s string) (string, bool) {
+	if len(l.Minus) == 0 {
+		if len(s) > 0 && s[0] == '-' {
+			return s[1:], true
+		}
+		return s, false
+	}
+	for _, m := range l.Minus {
+		if m != "" && strings.HasPrefix(s, m) {
+			return s[len(m):], true
+		}
+	}
+	return s, false
+}
+
+// group returns the group separator used in the integer part of a number:
+// Group if it occurs, otherwise the first of GroupAlternates that occurs, or Group if there is none.
+func (l Locale) group(s string) string {
+	if l.Group == "" || strings.Contains(s, l.Group) {
+		return l.Group
+	}
+	for _, g := range l.GroupAlternates {
+		if g != "" && strings.Contains(s, g) {
+			return g
+		}
+	}
+	return l.Group
+}
+
+// checkGrouping validates the positions of the group separator in the integer part of a number.
+// Integer parts without any group separator are always accepted.
+func (l Locale) checkGrouping(s, group string) error {
+	if group == "" || !strings.Contains(s, group) {
+		return nil
+	}
+	for i := 0; ; i++ {
+		size := l.groupSize(i)
+		j := strings.LastIndex(s, group)
+		if j < 0 {
+			if len(s) == 0 || len(s) > size {
+				return fmt.Errorf("invalid group of %d digits in integer: %s", len(s), s)
+			}
+			return nil
+		}
+		if n := len(s) - j - len(group); n != size {
+			return fmt.Errorf("invalid group of %d digits in integer, expected %d: %s", n, size, s)
+		}
+		s = s[:j]
+	}
+}
+
+// ParseLocale parses a decimal value from a string formatted according to the given locale.
+// The string must contain just the number with no additional characters around it.
+// Group separators are optional but if present, they must be placed according to the grouping of the locale.
+// Any of the alternate group separators of the locale may be used instead of Group, but not mixed with it.
+// It will parse at most 19 digits after the decimal point.
+// The integer component must fit into an unsigned 64-bit integer.
+func ParseLocale(s string, loc Locale) (Decimal, error) {
+	if loc.Group != "" && loc.Group == loc.decimal() {
+		return Zero(), fmt.Errorf("locale uses identical decimal and group separator: %q", loc.Group)
+	}
+	if slices.Contains(loc.GroupAlternates, loc.decimal()) {
+		return Zero(), fmt.Errorf("locale uses identical decimal and alternate group separator: %q", loc.decimal())
+	}
+	d := Zero()
+	in := s
+	s, d.Negative = loc.trimMinus(s)
+	integer, fraction, _ := strings.Cut(s, loc.decimal())
+	if len(integer) == 0 && len(fraction) == 0 {
+		return Zero(), fmt.Errorf("no number in string: %s", in)
+	}
+	group := loc.group(integer)
+	if err := loc.checkGrouping(integer, group); err != nil {
+		return Zero(), err
+	}
+
+	for pos := 0; pos < len(integer); pos++ {
+		if c := integer[pos]; c >= '0' && c <= '9' {
+			if d.Integer >= cutoff {
+				if d.Integer > cutoff || c > '5' {
+					return Zero(), fmt.Errorf("value overflows unsigned 64-bit integer: %s", in)
+				}
+			}
+			d.Integer = d.Integer*10 + uint64(c-'0')
+		} else if group != "" && strings.HasPrefix(integer[pos:], group) {
+			pos += len(group) - 1
+		} else {
+			return Zero(), fmt.Errorf("invalid character in integer: %s", integer[pos:])
+		}
+	}
+	for pos := 0; pos < len(fraction); pos++ {
+		if c := fraction[pos]; c >= '0' && c <= '9' {
+			if d.Digits >= 19 {
+				return Zero(), fmt.Errorf("more digits in fraction than can be represented: %d", pos)
+			}
+			d.Digits++
+			d.Fraction = d.Fraction*10 + uint64(c-'0')
+		} else {
+			return Zero(), fmt.Errorf("invalid character in fraction: %s", fraction[pos:])
+		}
+	}
+	if d.Integer == 0 && d.Fraction == 0 {
+		d.Negative = false
+	}
+	return d, nil
+}
+
+// ParseFixedLocale parses a fixed-point value from a string formatted according to the given locale.
+// It follows the rules of `ParseLocale` and additionally rejects fractional digits that cannot be represented
+// as well as values outside the range [-21474836.48, 21474836.47].
+func ParseFixedLocale(s string, loc Locale) (Fixed, error) {
+	d, err := ParseLocale(s, loc)
+	if err != nil {
+		return 0, err
+	}
+	return fixedFromDecimal(d)
+}
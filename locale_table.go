@@ -0,0 +1,60 @@
+// Code generated by go run ./internal/cldrgen; DO NOT EDIT.
+
+package decimal
+
+// locales contains number formatting data for common locales derived from the Unicode CLDR.
+var locales = map[string]Locale{
+	"en-US": {Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"en-GB": {Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"en-CA": {Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"en-AU": {Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"en-IN": {Decimal: ".", Group: ",", Grouping: []uint8{3, 2}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"hi-IN": {Decimal: ".", Group: ",", Grouping: []uint8{3, 2}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"de-DE": {Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"de-AT": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBeforeSpace},
+	"de-CH": {Decimal: ".", Group: "’", GroupAlternates: []string{"'"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBeforeSpace},
+	"fr-FR": {Decimal: ",", Group: "\u202f", GroupAlternates: []string{" ", "\u00a0"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"fr-CA": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"fr-CH": {Decimal: ",", Group: "\u202f", GroupAlternates: []string{" ", "\u00a0"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"it-IT": {Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"it-CH": {Decimal: ".", Group: "’", GroupAlternates: []string{"'"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBeforeSpace},
+	"es-ES": {Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"es-MX": {Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"pt-BR": {Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBeforeSpace},
+	"pt-PT": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"nl-NL": {Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBeforeSpace},
+	"da-DK": {Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"sv-SE": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"−", "-"}, CurrencyPlacement: SymbolAfterSpace},
+	"nb-NO": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"−", "-"}, CurrencyPlacement: SymbolAfterSpace},
+	"fi-FI": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"−", "-"}, CurrencyPlacement: SymbolAfterSpace},
+	"pl-PL": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"cs-CZ": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"ru-RU": {Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolAfterSpace},
+	"tr-TR": {Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"ja-JP": {Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"zh-CN": {Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+	"ko-KR": {Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: SymbolBefore},
+}
+
+// localeDefaults maps languages to the locale used when no region is given.
+var localeDefaults = map[string]string{
+	"en": "en-US",
+	"hi": "hi-IN",
+	"de": "de-DE",
+	"fr": "fr-FR",
+	"it": "it-IT",
+	"es": "es-ES",
+	"pt": "pt-BR",
+	"nl": "nl-NL",
+	"da": "da-DK",
+	"sv": "sv-SE",
+	"nb": "nb-NO",
+	"fi": "fi-FI",
+	"pl": "pl-PL",
+	"cs": "cs-CZ",
+	"ru": "ru-RU",
+	"tr": "tr-TR",
+	"ja": "ja-JP",
+	"zh": "zh-CN",
+	"ko": "ko-KR",
+}
@@ -0,0 +1,262 @@
+package decimal_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+var (
+	localeEN = decimal.Locale{Decimal: ".", Group: ",", Grouping: []uint8{3}}
+	localeDE = decimal.Locale{Decimal: ",", Group: ".", Grouping: []uint8{3}}
+	localeCH = decimal.Locale{Decimal: ".", Group: "'", Grouping: []uint8{3}, Minus: []string{"-", "−"}}
+	localeIN = decimal.Locale{Decimal: ".", Group: ",", Grouping: []uint8{3, 2}}
+)
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		loc     decimal.Locale
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"zero_value_locale", "-123.45", decimal.Locale{}, decimal.Decimal{Negative: true, Integer: 123, Fraction: 45, Digits: 2}, false},
+		{"zero_value_locale_rejects_group", "1,234", decimal.Locale{}, decimal.Decimal{}, true},
+		{"en_grouped", "1,234,567.89", localeEN, decimal.Decimal{Integer: 1234567, Fraction: 89, Digits: 2}, false},
+		{"en_ungrouped", "1234567.89", localeEN, decimal.Decimal{Integer: 1234567, Fraction: 89, Digits: 2}, false},
+		{"en_short_leading_group", "12,345", localeEN, decimal.Decimal{Integer: 12345}, false},
+		{"en_negative", "-1,234.5", localeEN, decimal.Decimal{Negative: true, Integer: 1234, Fraction: 5, Digits: 1}, false},
+		{"en_misplaced_group", "1,23,456.00", localeEN, decimal.Decimal{}, true},
+		{"en_long_leading_group", "1234,567", localeEN, decimal.Decimal{}, true},
+		{"en_empty_leading_group", ",234", localeEN, decimal.Decimal{}, true},
+		{"en_trailing_group", "1,234,", localeEN, decimal.Decimal{}, true},
+		{"en_group_in_fraction", "1.234,5", localeEN, decimal.Decimal{}, true},
+		{"de_grouped", "1.234.567,89", localeDE, decimal.Decimal{Integer: 1234567, Fraction: 89, Digits: 2}, false},
+		{"de_integer_only", "1.234", localeDE, decimal.Decimal{Integer: 1234}, false},
+		{"de_leading_separator", ",5", localeDE, decimal.Decimal{Fraction: 5, Digits: 1}, false},
+		{"de_trailing_separator", "12,", localeDE, decimal.Decimal{Integer: 12}, false},
+		{"de_dot_as_decimal", "1234.56", localeDE, decimal.Decimal{}, true},
+		{"ch_grouped", "1'234'567.89", localeCH, decimal.Decimal{Integer: 1234567, Fraction: 89, Digits: 2}, false},
+		{"ch_unicode_minus", "−1'234.50", localeCH, decimal.Decimal{Negative: true, Integer: 1234, Fraction: 50, Digits: 2}, false},
+		{"ch_negative_zero", "−0.00", localeCH, decimal.Decimal{Digits: 2}, false},
+		{"in_grouped", "12,34,567.89", localeIN, decimal.Decimal{Integer: 1234567, Fraction: 89, Digits: 2}, false},
+		{"in_large", "1,23,45,67,890", localeIN, decimal.Decimal{Integer: 1234567890}, false},
+		{"in_western_grouping", "1,234,567", localeIN, decimal.Decimal{}, true},
+		{"maxuint64", "18,446,744,073,709,551,615", localeEN, decimal.Decimal{Integer: 18446744073709551615}, false},
+		{"overflow", "18,446,744,073,709,551,616", localeEN, decimal.Decimal{}, true},
+		{"fraction_19_digits", "0.1234567890123456789", localeEN, decimal.Decimal{Fraction: 1234567890123456789, Digits: 19}, false},
+		{"fraction_overflow", "0.12345678901234567890", localeEN, decimal.Decimal{}, true},
+		{"empty", "", localeEN, decimal.Decimal{}, true},
+		{"bare_minus", "-", localeEN, decimal.Decimal{}, true},
+		{"bare_separator", ",", localeDE, decimal.Decimal{}, true},
+		{"invalid_character", "12a", localeEN, decimal.Decimal{}, true},
+		{"identical_separators", "1.234", decimal.Locale{Decimal: ".", Group: "."}, decimal.Decimal{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decimal.ParseLocale(tt.s, tt.loc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLocale() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLocale() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkParseLocale(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		s    string
+		loc  decimal.Locale
+	}{
+		{"plain", "1234567.89", decimal.Locale{}},
+		{"en", "1,234,567.89", localeEN},
+		{"de", "1.234.567,89", localeDE},
+		{"in", "12,34,567.89", localeIN},
+	}
+	for _, bb := range benchmarks {
+		b.Run(bb.name, func(b *testing.B) {
+			for b.Loop() {
+				_, _ = decimal.ParseLocale(bb.s, bb.loc)
+			}
+		})
+	}
+}
+
+func TestParseFixedLocale(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		loc     decimal.Locale
+		want    decimal.Fixed
+		wantErr bool
+	}{
+		{"de_grouped", "1.234.567,89", localeDE, 123456789, false},
+		{"ch_grouped", "1'234.5", localeCH, 123450, false},
+		{"in_negative", "-12,34,567.89", localeIN, -123456789, false},
+		{"trailing_zeros", "1,5000", localeDE, 150, false},
+		{"too_many_digits", "1,234", decimal.Locale{Decimal: ","}, 0, true},
+		{"max", "21.474.836,47", localeDE, 2147483647, false},
+		{"min", "-21.474.836,48", localeDE, -2147483648, false},
+		{"overflow", "21.474.836,48", localeDE, 0, true},
+		{"misplaced_group", "1.23,00", localeDE, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decimal.ParseFixedLocale(tt.s, tt.loc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseFixedLocale() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFixedLocale() = %d, want %d", int32(got), int32(tt.want))
+			}
+		})
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		tag    string
+		want   decimal.Locale
+		wantOK bool
+	}{
+		{"en-US", decimal.Locale{Decimal: ".", Group: ",", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: decimal.SymbolBefore}, true},
+		{"de-DE", decimal.Locale{Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: decimal.SymbolAfterSpace}, true},
+		{"de-CH", decimal.Locale{Decimal: ".", Group: "’", GroupAlternates: []string{"'"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: decimal.SymbolBeforeSpace}, true},
+		{"fr-FR", decimal.Locale{Decimal: ",", Group: "\u202f", GroupAlternates: []string{" ", "\u00a0"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: decimal.SymbolAfterSpace}, true},
+		{"hi-IN", decimal.Locale{Decimal: ".", Group: ",", Grouping: []uint8{3, 2}, Minus: []string{"-"}, CurrencyPlacement: decimal.SymbolBefore}, true},
+		{"sv-SE", decimal.Locale{Decimal: ",", Group: "\u00a0", GroupAlternates: []string{" ", "\u202f"}, Grouping: []uint8{3}, Minus: []string{"−", "-"}, CurrencyPlacement: decimal.SymbolAfterSpace}, true},
+		{"de_ch", decimal.Locale{Decimal: ".", Group: "’", GroupAlternates: []string{"'"}, Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: decimal.SymbolBeforeSpace}, true},
+		{"DE", decimal.Locale{Decimal: ",", Group: ".", Grouping: []uint8{3}, Minus: []string{"-"}, CurrencyPlacement: decimal.SymbolAfterSpace}, true},
+		{"xx-YY", decimal.Locale{}, false},
+		{"", decimal.Locale{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, ok := decimal.LookupLocale(tt.tag)
+			if ok != tt.wantOK {
+				t.Fatalf("LookupLocale(%q) ok = %v, want %v", tt.tag, ok, tt.wantOK)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LookupLocale(%q) = %#v, want %#v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupLocale_Isolated(t *testing.T) {
+	loc, _ := decimal.LookupLocale("hi-IN")
+	loc.Grouping[0] = 4
+	loc.Minus[0] = "~"
+	if again, _ := decimal.LookupLocale("hi-IN"); again.Grouping[0] != 3 || again.Minus[0] != "-" {
+		t.Errorf("LookupLocale() returned shared data: %#v", again)
+	}
+	loc, _ = decimal.LookupLocale("de-CH")
+	loc.GroupAlternates[0] = "~"
+	if again, _ := decimal.LookupLocale("de-CH"); again.GroupAlternates[0] != "'" {
+		t.Errorf("LookupLocale() returned shared data: %#v", again)
+	}
+}
+
+func TestLocale_CurrencyFormatter(t *testing.T) {
+	value := decimal.Decimal{Negative: true, Integer: 1234567, Fraction: 891, Digits: 3}
+	tests := []struct {
+		tag    string
+		symbol string
+		want   string
+	}{
+		{"en-US", "$", "-$1,234,567.89"},
+		{"de-DE", "€", "-1.234.567,89\u00a0€"},
+		{"de-CH", "CHF", "-CHF\u00a01’234’567.89"},
+		{"fr-FR", "€", "-1\u202f234\u202f567,89\u00a0€"},
+		{"hi-IN", "₹", "-₹12,34,567.89"},
+		{"ja-JP", "¥", "-¥1,234,567.89"},
+		{"pt-BR", "R$", "-R$\u00a01.234.567,89"},
+		{"sv-SE", "kr", "−1\u00a0234\u00a0567,89\u00a0kr"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			loc, ok := decimal.LookupLocale(tt.tag)
+			if !ok {
+				t.Fatalf("LookupLocale(%q) not found", tt.tag)
+			}
+			if got := loc.CurrencyFormatter(tt.symbol).Format(value); got != tt.want {
+				t.Errorf("CurrencyFormatter(%q).Format() = %q, want %q", tt.symbol, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupLocale_ParseRoundTrip(t *testing.T) {
+	value := decimal.Decimal{Negative: true, Integer: 1234567, Fraction: 89, Digits: 2}
+	for _, tag := range []string{"en-US", "de-DE", "de-CH", "fr-FR", "hi-IN", "ja-JP", "pt-BR", "sv-SE"} {
+		loc, _ := decimal.LookupLocale(tag)
+		s := decimal.Formatter{Locale: loc, MaxFraction: 2}.Format(value)
+		got, err := decimal.ParseLocale(s, loc)
+		if err != nil {
+			t.Fatalf("ParseLocale(%q, %s) error = %v", s, tag, err)
+		}
+		if got != value {
+			t.Errorf("ParseLocale(%q, %s) = %v, want %v", s, tag, got, value)
+		}
+	}
+}
+
+func TestLookupLocale_ParseAlternateGroup(t *testing.T) {
+	tests := []struct {
+		tag     string
+		s       string
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"de-CH", "1'234'567.89", decimal.Decimal{Integer: 1234567, Fraction: 89, Digits: 2}, false},
+		{"de-CH", "1’234’567.89", decimal.Decimal{Integer: 1234567, Fraction: 89, Digits: 2}, false},
+		{"it-CH", "-1'234.5", decimal.Decimal{Negative: true, Integer: 1234, Fraction: 5, Digits: 1}, false},
+		{"fr-FR", "1 234,5", decimal.Decimal{Integer: 1234, Fraction: 5, Digits: 1}, false},
+		{"fr-FR", "1\u00a0234,5", decimal.Decimal{Integer: 1234, Fraction: 5, Digits: 1}, false},
+		{"fr-FR", "1\u202f234,5", decimal.Decimal{Integer: 1234, Fraction: 5, Digits: 1}, false},
+		{"fr-CH", "12 345 678,9", decimal.Decimal{Integer: 12345678, Fraction: 9, Digits: 1}, false},
+		{"de-AT", "1 234,5", decimal.Decimal{Integer: 1234, Fraction: 5, Digits: 1}, false},
+		{"sv-SE", "−1 234,5", decimal.Decimal{Negative: true, Integer: 1234, Fraction: 5, Digits: 1}, false},
+		{"sv-SE", "1\u202f234,5", decimal.Decimal{Integer: 1234, Fraction: 5, Digits: 1}, false},
+		{"de-CH", "1'234’567.89", decimal.Decimal{}, true},
+		{"fr-FR", "1 234\u00a0567,8", decimal.Decimal{}, true},
+		{"de-CH", "12'34.5", decimal.Decimal{}, true},
+		{"fr-FR", "1 23,5", decimal.Decimal{}, true},
+		{"de-DE", "1'234,5", decimal.Decimal{}, true},
+		{"de-DE", "1 234,5", decimal.Decimal{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag+"/"+tt.s, func(t *testing.T) {
+			loc, ok := decimal.LookupLocale(tt.tag)
+			if !ok {
+				t.Fatalf("LookupLocale(%q) not found", tt.tag)
+			}
+			got, err := decimal.ParseLocale(tt.s, loc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLocale(%q, %s) error = %v, wantErr %v", tt.s, tt.tag, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLocale(%q, %s) = %#v, want %#v", tt.s, tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLocale_AlternateGroupIsDecimal(t *testing.T) {
+	loc := decimal.Locale{Decimal: ".", Group: "’", GroupAlternates: []string{"'", "."}}
+	if _, err := decimal.ParseLocale("1’234.5", loc); err == nil {
+		t.Errorf("ParseLocale() with alternate group separator equal to decimal separator succeeded")
+	}
+}
+
+func TestParseFixedLocale_AlternateGroup(t *testing.T) {
+	ch, _ := decimal.LookupLocale("de-CH")
+	if got, err := decimal.ParseFixedLocale("1'234.50", ch); err != nil || got != 123450 {
+		t.Errorf("ParseFixedLocale(\"1'234.50\", de-CH) = %v, %v, want 1234.50", got, err)
+	}
+}
@@ -0,0 +1,220 @@
+package decimal
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// The MySQL and MariaDB DECIMAL(M,D) storage format packs the integer and fractional digits separately into groups of 9 digits,
+// each stored as a 4-byte big-endian integer. Leftover digits at the start of the integer part and at the end of the fraction
+// use the smallest number of bytes that can hold them. Negative values have all bits inverted and the most significant bit
+// of the first byte is flipped, so the encoding sorts bytewise.
+const (
+	mysqlDecimalGroup        = 9
+	mysqlDecimalGroupBase    = 1_000_000_000
+	mysqlDecimalMaxPrecision = 65
+	mysqlDecimalMaxScale     = 30
+	mysqlDecimalMaxSize      = 30
+)
+
+// mysqlDecimalBytes is the number of bytes used for a group with the given number of digits.
+var mysqlDecimalBytes = [mysqlDecimalGroup + 1]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// mysqlDecimalLayout validates precision and scale and returns the number of integer and fractional digits.
+func mysqlDecimalLayout(precision, scale int) (int, int, error) {
+	if precision < 1 || precision > mysqlDecimalMaxPrecision {
+		return 0, 0, fmt.Errorf("mysql decimal: invalid precision %d", precision)
+	}
+	if scale < 0 || scale > mysqlDecimalMaxScale || scale > precision {
+		return 0, 0, fmt.Errorf("mysql decimal: invalid scale %d for precision %d", scale, precision)
+	}
+	return precision - scale, scale, nil
+}
+
+func mysqlDecimalSize(intg, frac int) int {
+	return intg/mysqlDecimalGroup*4 + mysqlDecimalBytes[intg%mysqlDecimalGroup] +
+		frac/mysqlDecimalGroup*4 + mysqlDecimalBytes[frac%mysqlDecimalGroup]
+}
+
+// MySQLDecimalSize returns the number of bytes used by the MySQL DECIMAL(precision, scale) storage format.
+// It returns 0 if precision or scale are outside the limits of MySQL (precision 1 to 65, scale 0 to 30 and at most precision).
+func MySQLDecimalSize(precision, scale int) int {
+	intg, frac, err := mysqlDecimalLayout(precision, scale)
+	if err != nil {
+		return 0
+	}
+	return mysqlDecimalSize(intg, frac)
+}
+
+// integerGroup returns the n digits of the integer starting shift digits from the right.
+func integerGroup(i uint64, shift, n int) uint64 {
+	if shift >= len(pow10) {
+		return 0
+	}
+	return i / pow10[shift] % pow10[n]
+}
+
+// fractionGroup returns the n fractional digits following the first start digits of a 19-digit fraction.
+func fractionGroup(f19 uint64, start, n int) uint64 {
+	if start >= 19 {
+		return 0
+	}
+	if end := start + n; end <= 19 {
+		return f19 / pow10[19-end] % pow10[n]
+	}
+	return f19 % pow10[19-start] * pow10[start+n-19]
+}
+
+// putGroup stores the lowest len(b) bytes of v in big-endian order.
+func putGroup(b []byte, v uint64) {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+}
+
+func getGroup(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+// AppendMySQLDecimal appends the decimal value in the MySQL and MariaDB DECIMAL(precision, scale) storage format to dst
+// and returns the extended buffer. It returns an error if precision or scale are invalid, if the integer part has more
+// than precision-scale digits or if the value has non-zero fractional digits beyond scale.
+func (d Decimal) AppendMySQLDecimal(dst []byte, precision, scale int) ([]byte, error) {
+	intg, frac, err := mysqlDecimalLayout(precision, scale)
+	if err != nil {
+		return dst, err
+	}
+	if intg < len(pow10) && d.Integer >= pow10[intg] {
+		return dst, fmt.Errorf("mysql decimal: integer part has more than %d digits", intg)
+	}
+	f19 := d.Fraction * pow10[19-d.Digits]
+	if frac < 19 && f19%pow10[19-frac] != 0 {
+		return dst, fmt.Errorf("mysql decimal: more digits in fraction than the scale %d", scale)
+	}
+
+	var buf [mysqlDecimalMaxSize]byte
+	pos := 0
+	full, lead := intg/mysqlDecimalGroup, intg%mysqlDecimalGroup
+	if lead > 0 {
+		n := mysqlDecimalBytes[lead]
+		putGroup(buf[:n], integerGroup(d.Integer, full*mysqlDecimalGroup, lead))
+		pos += n
+	}
+	for k := full - 1; k >= 0; k-- {
+		putGroup(buf[pos:pos+4], integerGroup(d.Integer, k*mysqlDecimalGroup, mysqlDecimalGroup))
+		pos += 4
+	}
+	full, lead = frac/mysqlDecimalGroup, frac%mysqlDecimalGroup
+	for k := range full {
+		putGroup(buf[pos:pos+4], fractionGroup(f19, k*mysqlDecimalGroup, mysqlDecimalGroup))
+		pos += 4
+	}
+	if lead > 0 {
+		n := mysqlDecimalBytes[lead]
+		putGroup(buf[pos:pos+n], fractionGroup(f19, full*mysqlDecimalGroup, lead))
+		pos += n
+	}
+
+	if d.Negative && (d.Integer != 0 || d.Fraction != 0) {
+		for i := range buf[:pos] {
+			buf[i] = ^buf[i]
+		}
+	}
+	buf[0] ^= 0x80
+	return append(dst, buf[:pos]...), nil
+}
+
+// DecodeMySQLDecimal decodes a value in the MySQL and MariaDB DECIMAL(precision, scale) storage format.
+// The length of b must match the size of the format exactly, see `MySQLDecimalSize`.
+// The number of fractional digits is taken from the scale, scales above 19 are accepted if the additional digits are zero.
+// Values with an integer part beyond the range of an unsigned 64-bit integer return an error.
+func DecodeMySQLDecimal(b []byte, precision, scale int) (Decimal, error) {
+	intg, frac, err := mysqlDecimalLayout(precision, scale)
+	if err != nil {
+		return Zero(), err
+	}
+	if size := mysqlDecimalSize(intg, frac); len(b) != size {
+		return Zero(), fmt.Errorf("mysql decimal: expected %d bytes for DECIMAL(%d,%d), got %d", size, precision, scale, len(b))
+	}
+
+	var buf [mysqlDecimalMaxSize]byte
+	copy(buf[:], b)
+	buf[0] ^= 0x80
+	var d Decimal
+	if buf[0]&0x80 != 0 {
+		d.Negative = true
+		for i := range buf[:len(b)] {
+			buf[i] = ^buf[i]
+		}
+	}
+
+	pos := 0
+	full, lead := intg/mysqlDecimalGroup, intg%mysqlDecimalGroup
+	if lead > 0 {
+		n := mysqlDecimalBytes[lead]
+		if d.Integer = getGroup(buf[:n]); d.Integer >= pow10[lead] {
+			return Zero(), fmt.Errorf("mysql decimal: invalid group of %d digits: %d", lead, d.Integer)
+		}
+		pos += n
+	}
+	for range full {
+		v := getGroup(buf[pos : pos+4])
+		if v >= mysqlDecimalGroupBase {
+			return Zero(), fmt.Errorf("mysql decimal: invalid group of %d digits: %d", mysqlDecimalGroup, v)
+		}
+		hi, lo := bits.Mul64(d.Integer, mysqlDecimalGroupBase)
+		var carry uint64
+		d.Integer, carry = bits.Add64(lo, v, 0)
+		if hi != 0 || carry != 0 {
+			return Zero(), fmt.Errorf("mysql decimal: value overflows unsigned 64-bit integer")
+		}
+		pos += 4
+	}
+
+	// The fraction is accumulated with 19 digits and reduced to the scale afterwards.
+	var f19 uint64
+	for start := 0; start < frac; start += mysqlDecimalGroup {
+		n := min(frac-start, mysqlDecimalGroup)
+		size := mysqlDecimalBytes[n]
+		v := getGroup(buf[pos : pos+size])
+		if v >= pow10[n] {
+			return Zero(), fmt.Errorf("mysql decimal: invalid group of %d digits: %d", n, v)
+		}
+		pos += size
+		switch end := start + n; {
+		case end <= 19:
+			f19 += v * pow10[19-end]
+		case start < 19 && v%pow10[end-19] == 0:
+			f19 += v / pow10[end-19]
+		case v != 0:
+			return Zero(), fmt.Errorf("mysql decimal: more digits in fraction than can be represented")
+		}
+	}
+	d.Digits = uint8(min(frac, 19))
+	d.Fraction = f19 / pow10[19-d.Digits]
+	if d.Integer == 0 && d.Fraction == 0 {
+		d.Negative = false
+	}
+	return d, nil
+}
+
+// AppendMySQLDecimal appends the fixed-point value in the MySQL and MariaDB DECIMAL(precision, scale) storage format to dst
+// and returns the extended buffer. It follows the rules of `Decimal.AppendMySQLDecimal`.
+func (f Fixed) AppendMySQLDecimal(dst []byte, precision, scale int) ([]byte, error) {
+	return f.Decimal().AppendMySQLDecimal(dst, precision, scale)
+}
+
+// DecodeFixedMySQLDecimal decodes a value in the MySQL and MariaDB DECIMAL(precision, scale) storage format as a fixed-point value.
+// It follows the rules of `DecodeMySQLDecimal` and rejects values that cannot be represented exactly.
+func DecodeFixedMySQLDecimal(b []byte, precision, scale int) (Fixed, error) {
+	d, err := DecodeMySQLDecimal(b, precision, scale)
+	if err != nil {
+		return 0, err
+	}
+	return fixedFromDecimal(d)
+}
@@ -0,0 +1,152 @@
+package decimal_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestDecimal_AppendMySQLDecimal(t *testing.T) {
+	tests := []struct {
+		name             string
+		d                decimal.Decimal
+		precision, scale int
+		hex              string
+	}{
+		{"mysql_manual", decimal.Decimal{Integer: 1234567890, Fraction: 1234, Digits: 4}, 14, 4, "810dfb38d204d2"},
+		{"mysql_manual_negative", decimal.Decimal{Negative: true, Integer: 1234567890, Fraction: 1234, Digits: 4}, 14, 4, "7ef204c72dfb2d"},
+		{"zero", decimal.Decimal{}, 1, 0, "80"},
+		{"zero_with_scale", decimal.Decimal{Digits: 2}, 5, 2, "800000"},
+		{"partial_groups", decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}, 10, 2, "8000000c32"},
+		{"small_negative", decimal.Decimal{Negative: true, Fraction: 1, Digits: 2}, 4, 2, "7ffe"},
+		{"max_uint64", decimal.Decimal{Integer: 18446744073709551615}, 65, 0, "8000000000000000000000000000000000000000121aa0c6092a4ae5ff"},
+		{"max_size", decimal.Decimal{Negative: true, Integer: 18446744073709551615, Fraction: 1234567890123456789, Digits: 19}, 65, 30, "7fffffffffffffede55f39f6d5b51a00f8a432eaff439eb1ca5b16ffffff"},
+		{"fraction_only", decimal.Decimal{Fraction: 1234567890123456789, Digits: 19}, 30, 30, "875bcd1500bc614e35a4e9000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.AppendMySQLDecimal(nil, tt.precision, tt.scale)
+			if err != nil {
+				t.Fatalf("AppendMySQLDecimal() error = %v", err)
+			}
+			if hex.EncodeToString(got) != tt.hex {
+				t.Errorf("AppendMySQLDecimal() = %x, want %s", got, tt.hex)
+			}
+			if size := decimal.MySQLDecimalSize(tt.precision, tt.scale); size != len(got) {
+				t.Errorf("MySQLDecimalSize(%d, %d) = %d, want %d", tt.precision, tt.scale, size, len(got))
+			}
+			back, err := decimal.DecodeMySQLDecimal(got, tt.precision, tt.scale)
+			if err != nil {
+				t.Fatalf("DecodeMySQLDecimal(%x) error = %v", got, err)
+			}
+			if want := tt.d.ToDigits(uint8(min(tt.scale, 19))); back != want {
+				t.Errorf("DecodeMySQLDecimal(%x) = %#v, want %#v", got, back, want)
+			}
+		})
+	}
+}
+
+func TestDecimal_AppendMySQLDecimal_Errors(t *testing.T) {
+	tests := []struct {
+		name             string
+		d                decimal.Decimal
+		precision, scale int
+	}{
+		{"precision_zero", decimal.Decimal{}, 0, 0},
+		{"precision_too_large", decimal.Decimal{}, 66, 0},
+		{"scale_too_large", decimal.Decimal{}, 65, 31},
+		{"scale_above_precision", decimal.Decimal{}, 4, 5},
+		{"negative_scale", decimal.Decimal{}, 4, -1},
+		{"integer_too_large", decimal.Decimal{Integer: 1000}, 5, 2},
+		{"fraction_beyond_scale", decimal.Decimal{Fraction: 125, Digits: 3}, 5, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.AppendMySQLDecimal([]byte{1}, tt.precision, tt.scale)
+			if err == nil {
+				t.Errorf("AppendMySQLDecimal() = %x, want error", got)
+			}
+			if len(got) != 1 {
+				t.Errorf("AppendMySQLDecimal() modified the buffer on error: %x", got)
+			}
+		})
+	}
+	if got, err := (decimal.Decimal{Integer: 999, Fraction: 1200, Digits: 4}).AppendMySQLDecimal(nil, 5, 2); err != nil || hex.EncodeToString(got) != "83e70c" {
+		t.Errorf("AppendMySQLDecimal() = %x, %v, want 83e70c", got, err)
+	}
+	if size := decimal.MySQLDecimalSize(66, 0); size != 0 {
+		t.Errorf("MySQLDecimalSize(66, 0) = %d, want 0", size)
+	}
+}
+
+func TestDecodeMySQLDecimal(t *testing.T) {
+	tests := []struct {
+		name             string
+		hex              string
+		precision, scale int
+		want             decimal.Decimal
+		wantErr          bool
+	}{
+		{"scale_above_19", "81" + "00000000" + "00000000" + "00000000" + "0000", 31, 30, decimal.Decimal{Integer: 1, Digits: 19}, false},
+		{"negative_zero", "7fffff", 5, 2, decimal.Decimal{Digits: 2}, false},
+		{"invalid_precision", "80", 0, 0, decimal.Decimal{}, true},
+		{"short", "810dfb38d204", 14, 4, decimal.Decimal{}, true},
+		{"trailing_data", "810dfb38d204d200", 14, 4, decimal.Decimal{}, true},
+		{"invalid_leading_group", "e4", 2, 0, decimal.Decimal{}, true},
+		{"invalid_full_group", "bb9aca00", 9, 0, decimal.Decimal{}, true},
+		{"invalid_fraction_group", "8064", 2, 2, decimal.Decimal{}, true},
+		{"integer_overflow", "8000000000000000000000000000000000000000121aa0c6092a4ae600", 65, 0, decimal.Decimal{}, true},
+		{"fraction_overflow", "80" + "00000000" + "00000000" + "0001", 22, 21, decimal.Decimal{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt.hex)
+			got, err := decimal.DecodeMySQLDecimal(b, tt.precision, tt.scale)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodeMySQLDecimal(%s) error = %v, wantErr %v", tt.hex, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DecodeMySQLDecimal(%s) = %#v, want %#v", tt.hex, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixed_MySQLDecimal(t *testing.T) {
+	for _, f := range []decimal.Fixed{0, 1, -1250, 2147483647, -2147483648} {
+		data, err := f.AppendMySQLDecimal(nil, 10, 2)
+		if err != nil {
+			t.Fatalf("AppendMySQLDecimal(%d) error = %v", int32(f), err)
+		}
+		got, err := decimal.DecodeFixedMySQLDecimal(data, 10, 2)
+		if err != nil || got != f {
+			t.Errorf("DecodeFixedMySQLDecimal(%x) = %d, %v, want %d", data, int32(got), err, int32(f))
+		}
+	}
+	if data, err := decimal.Fixed(1250).AppendMySQLDecimal(nil, 5, 1); err != nil || hex.EncodeToString(data) != "800c05" {
+		t.Errorf("AppendMySQLDecimal(12.50, 5, 1) = %x, %v, want 800c05", data, err)
+	}
+	if _, err := decimal.Fixed(1).AppendMySQLDecimal(nil, 5, 1); err == nil {
+		t.Error("AppendMySQLDecimal(0.01, 5, 1) expected error")
+	}
+	b, _ := hex.DecodeString("80007b")
+	if got, err := decimal.DecodeFixedMySQLDecimal(b, 4, 3); err == nil {
+		t.Errorf("DecodeFixedMySQLDecimal(0.123) = %d, want error", int32(got))
+	}
+}
+
+func BenchmarkDecimal_AppendMySQLDecimal(b *testing.B) {
+	d := decimal.Decimal{Negative: true, Integer: 1234567890, Fraction: 1234, Digits: 4}
+	buf := make([]byte, 0, 32)
+	for b.Loop() {
+		buf, _ = d.AppendMySQLDecimal(buf[:0], 14, 4)
+	}
+}
+
+func BenchmarkDecodeMySQLDecimal(b *testing.B) {
+	data, _ := hex.DecodeString("7ef204c72dfb2d")
+	for b.Loop() {
+		_, _ = decimal.DecodeMySQLDecimal(data, 14, 4)
+	}
+}
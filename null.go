@@ -0,0 +1,233 @@
+package decimal
+
+import "database/sql/driver"
+
+// NullDecimal represents a decimal value that may be null, modeled on `sql.NullInt64`.
+// Null is kept distinct from zero by all encodings: SQL NULL, JSON `null`, CBOR `null` and empty text.
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool // Valid is true if Decimal is not null
+}
+
+// Scan implements the sql.Scanner interface.
+// NULL sets Valid to false, all other values are decoded by `Decimal.Scan`.
+func (n *NullDecimal) Scan(value any) error {
+	if value == nil {
+		n.Decimal, n.Valid = Zero(), false
+		return nil
+	}
+	n.Valid = true
+	return n.Decimal.Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil for null values and the result of `Decimal.Value` otherwise.
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Decimal.Value()
+}
+
+// String returns the string representation of a valid value or "null".
+func (n NullDecimal) String() string {
+	if !n.Valid {
+		return "null"
+	}
+	return n.Decimal.String()
+}
+
+// MarshalJSON encodes a null value as JSON `null` and a valid value as a JSON number.
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Decimal.MarshalJSON()
+}
+
+// UnmarshalJSON decodes JSON `null` as a null value and everything else as described by `Decimal.UnmarshalJSON`.
+func (n *NullDecimal) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		n.Decimal, n.Valid = Zero(), false
+		return nil
+	}
+	if err := n.Decimal.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. Null values are encoded as empty text.
+func (n NullDecimal) AppendText(b []byte) ([]byte, error) {
+	if !n.Valid {
+		return b, nil
+	}
+	return n.Decimal.AppendText(b)
+}
+
+// MarshalText implements encoding.TextMarshaler. Null values are encoded as empty text.
+func (n NullDecimal) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Decimal.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is decoded as a null value.
+func (n *NullDecimal) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		n.Decimal, n.Valid = Zero(), false
+		return nil
+	}
+	if err := n.Decimal.UnmarshalText(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface.
+// Null values are encoded as the CBOR simple value `null`, valid values as described by `Decimal.MarshalCBOR`.
+func (n NullDecimal) MarshalCBOR() ([]byte, error) {
+	return n.AppendCBOR(nil)
+}
+
+// AppendCBOR appends the CBOR encoding of the value as produced by `MarshalCBOR` to b and returns the extended buffer.
+func (n NullDecimal) AppendCBOR(b []byte) ([]byte, error) {
+	if !n.Valid {
+		return append(b, CBOR_NULL), nil
+	}
+	return n.Decimal.AppendCBOR(b)
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface.
+// The simple values `null` and `undefined` are decoded as a null value, everything else as described by `Decimal.UnmarshalCBOR`.
+func (n *NullDecimal) UnmarshalCBOR(data []byte) error {
+	if isCBORNull(data) {
+		n.Decimal, n.Valid = Zero(), false
+		return nil
+	}
+	if err := n.Decimal.UnmarshalCBOR(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// NullFixed represents a fixed-point value that may be null, modeled on `sql.NullInt64`.
+// Null is kept distinct from zero by all encodings: SQL NULL, JSON `null`, CBOR `null` and empty text.
+type NullFixed struct {
+	Fixed Fixed
+	Valid bool // Valid is true if Fixed is not null
+}
+
+// Scan implements the sql.Scanner interface.
+// NULL sets Valid to false, all other values are decoded by `Fixed.Scan`.
+func (n *NullFixed) Scan(value any) error {
+	if value == nil {
+		n.Fixed, n.Valid = 0, false
+		return nil
+	}
+	n.Valid = true
+	return n.Fixed.Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil for null values and the result of `Fixed.Value` otherwise.
+func (n NullFixed) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Fixed.Value()
+}
+
+// String returns the string representation of a valid value or "null".
+func (n NullFixed) String() string {
+	if !n.Valid {
+		return "null"
+	}
+	return n.Fixed.String()
+}
+
+// MarshalJSON encodes a null value as JSON `null` and a valid value as a JSON number.
+func (n NullFixed) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Fixed.MarshalJSON()
+}
+
+// UnmarshalJSON decodes JSON `null` as a null value and everything else as described by `Fixed.UnmarshalJSON`.
+func (n *NullFixed) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		n.Fixed, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Fixed.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// AppendText implements encoding.TextAppender. Null values are encoded as empty text.
+func (n NullFixed) AppendText(b []byte) ([]byte, error) {
+	if !n.Valid {
+		return b, nil
+	}
+	return n.Fixed.AppendText(b)
+}
+
+// MarshalText implements encoding.TextMarshaler. Null values are encoded as empty text.
+func (n NullFixed) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Fixed.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is decoded as a null value.
+func (n *NullFixed) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		n.Fixed, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Fixed.UnmarshalText(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface.
+// Null values are encoded as the CBOR simple value `null`, valid values as described by `Fixed.MarshalCBOR`.
+func (n NullFixed) MarshalCBOR() ([]byte, error) {
+	return n.AppendCBOR(nil)
+}
+
+// AppendCBOR appends the CBOR encoding of the value as produced by `MarshalCBOR` to b and returns the extended buffer.
+func (n NullFixed) AppendCBOR(b []byte) ([]byte, error) {
+	if !n.Valid {
+		return append(b, CBOR_NULL), nil
+	}
+	return n.Fixed.AppendCBOR(b)
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface.
+// The simple values `null` and `undefined` are decoded as a null value, everything else as described by `Fixed.UnmarshalCBOR`.
+func (n *NullFixed) UnmarshalCBOR(data []byte) error {
+	if isCBORNull(data) {
+		n.Fixed, n.Valid = 0, false
+		return nil
+	}
+	if err := n.Fixed.UnmarshalCBOR(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+func isCBORNull(data []byte) bool {
+	return len(data) == 1 && (data[0] == CBOR_NULL || data[0] == CBOR_UNDEFINED)
+}
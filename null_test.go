@@ -0,0 +1,199 @@
+package decimal_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+var (
+	_ sql.Scanner            = (*decimal.NullDecimal)(nil)
+	_ driver.Valuer          = decimal.NullDecimal{}
+	_ encoding.TextAppender  = decimal.NullDecimal{}
+	_ encoding.TextMarshaler = decimal.NullFixed{}
+	_ sql.Scanner            = (*decimal.NullFixed)(nil)
+	_ driver.Valuer          = decimal.NullFixed{}
+)
+
+func TestNullDecimal_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    decimal.NullDecimal
+		wantErr bool
+	}{
+		{"null", nil, decimal.NullDecimal{}, false},
+		{"zero", int64(0), decimal.NullDecimal{Valid: true}, false},
+		{"string", "-12.50", decimal.NullDecimal{Decimal: decimal.Decimal{Negative: true, Integer: 12, Fraction: 50, Digits: 2}, Valid: true}, false},
+		{"bytes", []byte("3.5"), decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 3, Fraction: 5, Digits: 1}, Valid: true}, false},
+		{"invalid_type", true, decimal.NullDecimal{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 7}, Valid: !tt.want.Valid}
+			err := n.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NullDecimal.Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && n != tt.want {
+				t.Errorf("NullDecimal.Scan() = %#v, want %#v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDecimal_Value(t *testing.T) {
+	if v, err := (decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 1}}).Value(); err != nil || v != nil {
+		t.Errorf("NullDecimal.Value() = %v, %v, want nil", v, err)
+	}
+	if v, err := (decimal.NullDecimal{Decimal: decimal.Decimal{Fraction: 5, Digits: 2}, Valid: true}).Value(); err != nil || v != "0.05" {
+		t.Errorf("NullDecimal.Value() = %v, %v, want 0.05", v, err)
+	}
+}
+
+func TestNullDecimal_JSON(t *testing.T) {
+	type S struct {
+		D decimal.NullDecimal `json:"d"`
+	}
+	tests := []struct {
+		name string
+		s    S
+		json string
+	}{
+		{"null", S{}, `{"d":null}`},
+		{"zero", S{decimal.NullDecimal{Valid: true}}, `{"d":0}`},
+		{"value", S{decimal.NullDecimal{Decimal: decimal.Decimal{Negative: true, Integer: 1, Fraction: 50, Digits: 2}, Valid: true}}, `{"d":-1.50}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.s)
+			if err != nil || string(got) != tt.json {
+				t.Errorf("json.Marshal() = %s, %v, want %s", got, err, tt.json)
+			}
+			back := S{decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 9}, Valid: !tt.s.D.Valid}}
+			if err := json.Unmarshal([]byte(tt.json), &back); err != nil || back != tt.s {
+				t.Errorf("json.Unmarshal(%s) = %#v, %v, want %#v", tt.json, back, err, tt.s)
+			}
+		})
+	}
+	var s S
+	if err := json.Unmarshal([]byte(`{"d":"x"}`), &s); err == nil {
+		t.Error("json.Unmarshal() expected error")
+	}
+}
+
+func TestNullDecimal_Text(t *testing.T) {
+	tests := []struct {
+		name string
+		n    decimal.NullDecimal
+		text string
+	}{
+		{"null", decimal.NullDecimal{}, ""},
+		{"zero", decimal.NullDecimal{Valid: true}, "0"},
+		{"value", decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 12, Fraction: 3, Digits: 1}, Valid: true}, "12.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.n.MarshalText()
+			if err != nil || string(got) != tt.text {
+				t.Errorf("NullDecimal.MarshalText() = %q, %v, want %q", got, err, tt.text)
+			}
+			got, err = tt.n.AppendText([]byte("x="))
+			if err != nil || string(got) != "x="+tt.text {
+				t.Errorf("NullDecimal.AppendText() = %q, %v, want %q", got, err, "x="+tt.text)
+			}
+			back := decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 9}, Valid: !tt.n.Valid}
+			if err := back.UnmarshalText([]byte(tt.text)); err != nil || back != tt.n {
+				t.Errorf("NullDecimal.UnmarshalText(%q) = %#v, %v, want %#v", tt.text, back, err, tt.n)
+			}
+		})
+	}
+	if s := (decimal.NullDecimal{}).String(); s != "null" {
+		t.Errorf("NullDecimal.String() = %q, want null", s)
+	}
+}
+
+func TestNullDecimal_CBOR(t *testing.T) {
+	tests := []struct {
+		name string
+		n    decimal.NullDecimal
+		hex  string
+	}{
+		{"null", decimal.NullDecimal{}, "f6"},
+		{"zero", decimal.NullDecimal{Valid: true}, "00"},
+		{"value", decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, Valid: true}, "c482200f"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.n.MarshalCBOR()
+			if err != nil || hex.EncodeToString(got) != tt.hex {
+				t.Errorf("NullDecimal.MarshalCBOR() = %x, %v, want %s", got, err, tt.hex)
+			}
+			back := decimal.NullDecimal{Decimal: decimal.Decimal{Integer: 9}, Valid: !tt.n.Valid}
+			if err := back.UnmarshalCBOR(got); err != nil || back != tt.n {
+				t.Errorf("NullDecimal.UnmarshalCBOR(%x) = %#v, %v, want %#v", got, back, err, tt.n)
+			}
+		})
+	}
+	n := decimal.NullDecimal{Valid: true}
+	if err := n.UnmarshalCBOR(cborHex("f7")); err != nil || n.Valid {
+		t.Errorf("NullDecimal.UnmarshalCBOR(undefined) = %#v, %v, want null", n, err)
+	}
+	if err := n.UnmarshalCBOR(cborHex("f5")); err == nil {
+		t.Error("NullDecimal.UnmarshalCBOR(true) expected error")
+	}
+}
+
+func TestNullFixed(t *testing.T) {
+	var n decimal.NullFixed
+	if err := n.Scan("12.34"); err != nil || n != (decimal.NullFixed{Fixed: 1234, Valid: true}) {
+		t.Errorf("NullFixed.Scan() = %#v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != "12.34" {
+		t.Errorf("NullFixed.Value() = %v, %v, want 12.34", v, err)
+	}
+	if err := n.Scan(nil); err != nil || n != (decimal.NullFixed{}) {
+		t.Errorf("NullFixed.Scan(nil) = %#v, %v", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("NullFixed.Value() = %v, %v, want nil", v, err)
+	}
+	if err := n.Scan("0.001"); err == nil {
+		t.Error("NullFixed.Scan(0.001) expected error")
+	}
+
+	for _, tt := range []struct {
+		n          decimal.NullFixed
+		text, json string
+		cbor       string
+	}{
+		{decimal.NullFixed{}, "", "null", "f6"},
+		{decimal.NullFixed{Valid: true}, "0.00", "0.00", "c4822100"},
+		{decimal.NullFixed{Fixed: -150, Valid: true}, "-1.50", "-1.50", "c482213895"},
+	} {
+		if got, err := tt.n.MarshalText(); err != nil || string(got) != tt.text {
+			t.Errorf("NullFixed.MarshalText() = %q, %v, want %q", got, err, tt.text)
+		}
+		if got, err := tt.n.MarshalJSON(); err != nil || string(got) != tt.json {
+			t.Errorf("NullFixed.MarshalJSON() = %s, %v, want %s", got, err, tt.json)
+		}
+		if got, err := tt.n.MarshalCBOR(); err != nil || hex.EncodeToString(got) != tt.cbor {
+			t.Errorf("NullFixed.MarshalCBOR() = %x, %v, want %s", got, err, tt.cbor)
+		}
+		var text, js, cb decimal.NullFixed
+		if err := text.UnmarshalText([]byte(tt.text)); err != nil || text != tt.n {
+			t.Errorf("NullFixed.UnmarshalText(%q) = %#v, %v", tt.text, text, err)
+		}
+		if err := js.UnmarshalJSON([]byte(tt.json)); err != nil || js != tt.n {
+			t.Errorf("NullFixed.UnmarshalJSON(%s) = %#v, %v", tt.json, js, err)
+		}
+		if err := cb.UnmarshalCBOR(cborHex(tt.cbor)); err != nil || cb != tt.n {
+			t.Errorf("NullFixed.UnmarshalCBOR(%s) = %#v, %v", tt.cbor, cb, err)
+		}
+	}
+}
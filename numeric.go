@@ -0,0 +1,169 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// NumericSpec describes the precision and scale of a `Numeric` type like the parameters of the SQL type NUMERIC(precision, scale).
+// Precision is the total number of significant digits and scale the number of digits after the decimal point.
+// The scale must be between 0 and 19 and the precision at least 1 and not less than the scale.
+//
+// Specs are usually empty struct types:
+//
+//	type Price struct{}
+//
+//	func (Price) Precision() int { return 12 }
+//	func (Price) Scale() int     { return 4 }
+type NumericSpec interface {
+	Precision() int
+	Scale() int
+}
+
+// NumericRounding can be implemented by a `NumericSpec` to round values with too many fractional digits
+// using the returned mode instead of rejecting them.
+type NumericRounding interface {
+	Rounding() RoundingMode
+}
+
+// Numeric is a decimal value constrained to the precision and scale given by S, mirroring SQL NUMERIC(p,s).
+// Values are validated on construction and whenever they are decoded, they always carry exactly `Scale` fractional digits.
+// Values with more fractional digits are rejected unless S implements `NumericRounding`,
+// values with more than `Precision - Scale` integer digits are always rejected.
+// The zero value is zero.
+type Numeric[S NumericSpec] struct {
+	d Decimal
+}
+
+// NewNumeric converts a decimal value to a constrained numeric value.
+// It returns an error if the value does not fit into the precision and scale of S.
+func NewNumeric[S NumericSpec](d Decimal) (Numeric[S], error) {
+	var n Numeric[S]
+	if err := n.set(d); err != nil {
+		return Numeric[S]{}, err
+	}
+	return n, nil
+}
+
+func (n *Numeric[S]) set(d Decimal) error {
+	var spec S
+	precision, scale := spec.Precision(), spec.Scale()
+	if scale < 0 || scale > 19 || precision < 1 || precision < scale {
+		return fmt.Errorf("invalid numeric spec NUMERIC(%d,%d)", precision, scale)
+	}
+	if int(d.Digits) > scale {
+		if r, ok := any(spec).(NumericRounding); ok {
+			rounded := d.RoundMode(uint8(scale), r.Rounding())
+			if rounded.Integer < d.Integer {
+				return fmt.Errorf("value overflows unsigned 64-bit integer when rounded: %v", d)
+			}
+			d = rounded
+		} else if d = d.Truncate(); int(d.Digits) > scale {
+			return fmt.Errorf("value has more than %d fractional digits allowed by NUMERIC(%d,%d): %v", scale, precision, scale, d)
+		}
+	}
+	if integer := precision - scale; integer < len(pow10) && d.Integer >= pow10[integer] {
+		return fmt.Errorf("value has more than %d integer digits allowed by NUMERIC(%d,%d): %v", integer, precision, scale, d)
+	}
+	n.d = d.ToDigits(uint8(scale))
+	return nil
+}
+
+// Decimal returns the value with exactly `Scale` fractional digits.
+func (n Numeric[S]) Decimal() Decimal {
+	var spec S
+	return n.d.ToDigits(uint8(max(spec.Scale(), 0)))
+}
+
+// Precision returns the total number of significant digits allowed by S.
+func (n Numeric[S]) Precision() int {
+	var spec S
+	return spec.Precision()
+}
+
+// Scale returns the number of digits after the decimal point of S.
+func (n Numeric[S]) Scale() int {
+	var spec S
+	return spec.Scale()
+}
+
+// SQLType returns the SQL type matching the constraint, e.g. "NUMERIC(12,4)", for use in schema generation.
+func (n Numeric[S]) SQLType() string {
+	var spec S
+	return fmt.Sprintf("NUMERIC(%d,%d)", spec.Precision(), spec.Scale())
+}
+
+// String returns the string representation of the value with exactly `Scale` fractional digits.
+func (n Numeric[S]) String() string {
+	return n.Decimal().String()
+}
+
+// Scan converts SQL data into a constrained numeric value as described by `Decimal.Scan` and validates it.
+// The receiver is left unchanged if an error is returned.
+func (n *Numeric[S]) Scan(value any) error {
+	var d Decimal
+	if err := d.Scan(value); err != nil {
+		return err
+	}
+	return n.set(d)
+}
+
+// Value encodes the value for SQL using the string representation of `Decimal.Value`.
+func (n Numeric[S]) Value() (driver.Value, error) {
+	return n.Decimal().Value()
+}
+
+// MarshalJSON encodes the value as a JSON number.
+func (n Numeric[S]) MarshalJSON() ([]byte, error) {
+	return n.Decimal().MarshalJSON()
+}
+
+// UnmarshalJSON decodes the value as described by `Decimal.UnmarshalJSON` and validates it.
+// The receiver is left unchanged if an error is returned.
+func (n *Numeric[S]) UnmarshalJSON(data []byte) error {
+	var d Decimal
+	if err := d.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	return n.set(d)
+}
+
+// AppendText implements encoding.TextAppender.
+func (n Numeric[S]) AppendText(b []byte) ([]byte, error) {
+	return n.Decimal().AppendText(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (n Numeric[S]) MarshalText() ([]byte, error) {
+	return n.Decimal().MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler and validates the value.
+// The receiver is left unchanged if an error is returned.
+func (n *Numeric[S]) UnmarshalText(data []byte) error {
+	var d Decimal
+	if err := d.UnmarshalText(data); err != nil {
+		return err
+	}
+	return n.set(d)
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface, identical to Decimal.MarshalCBOR.
+func (n Numeric[S]) MarshalCBOR() ([]byte, error) {
+	return n.Decimal().AppendCBOR(nil)
+}
+
+// AppendCBOR appends the CBOR encoding of the value as produced by `MarshalCBOR` to b and returns the extended buffer.
+func (n Numeric[S]) AppendCBOR(b []byte) ([]byte, error) {
+	return n.Decimal().AppendCBOR(b)
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface. It decodes the value as a Decimal and validates it.
+// The receiver is left unchanged if an error is returned.
+func (n *Numeric[S]) UnmarshalCBOR(data []byte) error {
+	var d Decimal
+	if err := d.UnmarshalCBOR(data); err != nil {
+		return err
+	}
+	return n.set(d)
+}
@@ -0,0 +1,161 @@
+package decimal_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+type numeric12x4 struct{}
+
+func (numeric12x4) Precision() int { return 12 }
+func (numeric12x4) Scale() int     { return 4 }
+
+type numeric5x2Rounded struct{}
+
+func (numeric5x2Rounded) Precision() int                 { return 5 }
+func (numeric5x2Rounded) Scale() int                     { return 2 }
+func (numeric5x2Rounded) Rounding() decimal.RoundingMode { return decimal.RoundHalfEven }
+
+type numeric20x0Rounded struct{}
+
+func (numeric20x0Rounded) Precision() int                 { return 20 }
+func (numeric20x0Rounded) Scale() int                     { return 0 }
+func (numeric20x0Rounded) Rounding() decimal.RoundingMode { return decimal.RoundUp }
+
+type numericInvalid struct{}
+
+func (numericInvalid) Precision() int { return 2 }
+func (numericInvalid) Scale() int     { return 3 }
+
+var (
+	_ sql.Scanner   = (*decimal.Numeric[numeric12x4])(nil)
+	_ driver.Valuer = decimal.Numeric[numeric12x4]{}
+)
+
+func TestNewNumeric(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       decimal.Decimal
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"zero", decimal.Decimal{}, decimal.Decimal{Digits: 4}, false},
+		{"padded", decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, decimal.Decimal{Integer: 12, Fraction: 5000, Digits: 4}, false},
+		{"negative", decimal.Decimal{Negative: true, Fraction: 1234, Digits: 4}, decimal.Decimal{Negative: true, Fraction: 1234, Digits: 4}, false},
+		{"trailing_zeros", decimal.Decimal{Integer: 1, Fraction: 250000, Digits: 6}, decimal.Decimal{Integer: 1, Fraction: 2500, Digits: 4}, false},
+		{"max", decimal.Decimal{Integer: 99999999, Fraction: 9999, Digits: 4}, decimal.Decimal{Integer: 99999999, Fraction: 9999, Digits: 4}, false},
+		{"too_many_integer_digits", decimal.Decimal{Integer: 100000000}, decimal.Decimal{Digits: 4}, true},
+		{"too_many_fractional_digits", decimal.Decimal{Fraction: 12345, Digits: 5}, decimal.Decimal{Digits: 4}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := decimal.NewNumeric[numeric12x4](tt.d)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewNumeric() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := n.Decimal(); got != tt.want {
+				t.Errorf("NewNumeric().Decimal() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewNumeric_Rounding(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       decimal.Decimal
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"tie_to_even", decimal.Decimal{Integer: 1, Fraction: 125, Digits: 3}, decimal.Decimal{Integer: 1, Fraction: 12, Digits: 2}, false},
+		{"round_up", decimal.Decimal{Integer: 1, Fraction: 126, Digits: 3}, decimal.Decimal{Integer: 1, Fraction: 13, Digits: 2}, false},
+		{"carry_within_precision", decimal.Decimal{Integer: 99, Fraction: 999, Digits: 3}, decimal.Decimal{Integer: 100, Digits: 2}, false},
+		{"carry_beyond_precision", decimal.Decimal{Integer: 999, Fraction: 996, Digits: 3}, decimal.Decimal{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := decimal.NewNumeric[numeric5x2Rounded](tt.d)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewNumeric() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && n.Decimal() != tt.want {
+				t.Errorf("NewNumeric().Decimal() = %#v, want %#v", n.Decimal(), tt.want)
+			}
+		})
+	}
+	if n, err := decimal.NewNumeric[numeric20x0Rounded](decimal.Decimal{Integer: 18446744073709551615, Fraction: 1, Digits: 1}); err == nil {
+		t.Errorf("NewNumeric() = %v, want overflow error", n)
+	}
+}
+
+func TestNumeric_Spec(t *testing.T) {
+	var n decimal.Numeric[numeric12x4]
+	if n.Precision() != 12 || n.Scale() != 4 || n.SQLType() != "NUMERIC(12,4)" {
+		t.Errorf("Numeric spec = %d, %d, %s", n.Precision(), n.Scale(), n.SQLType())
+	}
+	if n.String() != "0.0000" {
+		t.Errorf("Numeric.String() = %s, want 0.0000", n.String())
+	}
+	if _, err := decimal.NewNumeric[numericInvalid](decimal.Decimal{}); err == nil {
+		t.Error("NewNumeric() with invalid spec expected error")
+	}
+}
+
+func TestNumeric_Decode(t *testing.T) {
+	valid := decimal.Decimal{Negative: true, Integer: 12, Fraction: 5000, Digits: 4}
+	initial := decimal.Decimal{Integer: 7, Digits: 4}
+	tests := []struct {
+		name    string
+		decode  func(*decimal.Numeric[numeric12x4]) error
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"scan", func(n *decimal.Numeric[numeric12x4]) error { return n.Scan("-12.5") }, valid, false},
+		{"scan_null", func(n *decimal.Numeric[numeric12x4]) error { return n.Scan(nil) }, decimal.Decimal{Digits: 4}, false},
+		{"scan_invalid", func(n *decimal.Numeric[numeric12x4]) error { return n.Scan("0.00001") }, initial, true},
+		{"scan_bad_type", func(n *decimal.Numeric[numeric12x4]) error { return n.Scan(true) }, initial, true},
+		{"json", func(n *decimal.Numeric[numeric12x4]) error { return json.Unmarshal([]byte(`-12.50`), n) }, valid, false},
+		{"json_invalid", func(n *decimal.Numeric[numeric12x4]) error { return json.Unmarshal([]byte(`123456789`), n) }, initial, true},
+		{"text", func(n *decimal.Numeric[numeric12x4]) error { return n.UnmarshalText([]byte("-12.500000")) }, valid, false},
+		{"text_invalid", func(n *decimal.Numeric[numeric12x4]) error { return n.UnmarshalText([]byte("1.23456")) }, initial, true},
+		{"cbor", func(n *decimal.Numeric[numeric12x4]) error { return n.UnmarshalCBOR(cborHex("c482213904e1")) }, valid, false},
+		{"cbor_invalid", func(n *decimal.Numeric[numeric12x4]) error { return n.UnmarshalCBOR(cborHex("c482281f01")) }, initial, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, _ := decimal.NewNumeric[numeric12x4](initial)
+			err := tt.decode(&n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("decode error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := n.Decimal(); got != tt.want {
+				t.Errorf("decoded = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumeric_Encode(t *testing.T) {
+	n, _ := decimal.NewNumeric[numeric12x4](decimal.Decimal{Integer: 3, Fraction: 5, Digits: 1})
+	if v, err := n.Value(); err != nil || v != "3.5000" {
+		t.Errorf("Numeric.Value() = %v, %v, want 3.5000", v, err)
+	}
+	if b, err := json.Marshal(struct{ N decimal.Numeric[numeric12x4] }{n}); err != nil || string(b) != `{"N":3.5000}` {
+		t.Errorf("json.Marshal() = %s, %v", b, err)
+	}
+	if b, err := n.AppendText([]byte("n=")); err != nil || string(b) != "n=3.5000" {
+		t.Errorf("Numeric.AppendText() = %s, %v", b, err)
+	}
+	b, err := n.MarshalCBOR()
+	if err != nil {
+		t.Fatalf("Numeric.MarshalCBOR() error = %v", err)
+	}
+	var back decimal.Numeric[numeric12x4]
+	if err := back.UnmarshalCBOR(b); err != nil || back != n {
+		t.Errorf("Numeric CBOR round trip = %v, %v, want %v", back, err, n)
+	}
+}
@@ -0,0 +1,150 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// The PostgreSQL NUMERIC binary format consists of four 16-bit big-endian header fields followed by the digits:
+// the number of base-10000 digits, the weight of the first digit, the sign and the display scale.
+// The value is the sum of digit[i] * 10000^(weight-i). Leading and trailing zero digits are omitted.
+const (
+	pgNumericPositive = 0x0000
+	pgNumericNegative = 0x4000
+	pgNumericNaN      = 0xC000
+	pgNumericPosInf   = 0xD000
+	pgNumericNegInf   = 0xF000
+	pgNumericBase     = 10000
+)
+
+// AppendPgNumeric appends the decimal value in the binary format of the PostgreSQL NUMERIC type to dst
+// and returns the extended buffer. The display scale is the number of fractional digits of the value.
+func (d Decimal) AppendPgNumeric(dst []byte) []byte {
+	// At most 5 digits for the integer and 5 digits for the fraction.
+	var groups [10]uint16
+	n := 0
+	for i := d.Integer; i > 0; i /= pgNumericBase {
+		n++
+	}
+	for i, v := n-1, d.Integer; i >= 0; i, v = i-1, v/pgNumericBase {
+		groups[i] = uint16(v % pgNumericBase)
+	}
+	weight := n - 1
+	for rem := int(d.Digits); rem > 0; rem -= 4 {
+		if rem >= 4 {
+			groups[n] = uint16(d.Fraction / pow10[rem-4] % pgNumericBase)
+		} else {
+			groups[n] = uint16(d.Fraction % pow10[rem] * pow10[4-rem])
+		}
+		n++
+	}
+	start := 0
+	for start < n && groups[start] == 0 {
+		start++
+		weight--
+	}
+	for n > start && groups[n-1] == 0 {
+		n--
+	}
+	sign := uint16(pgNumericPositive)
+	if d.Negative {
+		sign = pgNumericNegative
+	}
+	if start == n {
+		weight, sign = 0, pgNumericPositive
+	}
+	dst = binary.BigEndian.AppendUint16(dst, uint16(n-start))
+	dst = binary.BigEndian.AppendUint16(dst, uint16(int16(weight)))
+	dst = binary.BigEndian.AppendUint16(dst, sign)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(d.Digits))
+	for _, g := range groups[start:n] {
+		dst = binary.BigEndian.AppendUint16(dst, g)
+	}
+	return dst
+}
+
+// DecodePgNumeric decodes a value in the binary format of the PostgreSQL NUMERIC type.
+// The number of fractional digits is taken from the display scale, scales above 19 are accepted
+// if the additional digits are zero. NaN, infinities and values outside the range of `Decimal` return an error.
+func DecodePgNumeric(b []byte) (Decimal, error) {
+	if len(b) < 8 {
+		return Zero(), fmt.Errorf("pg numeric: not enough data for header: %d bytes", len(b))
+	}
+	ndigits := int(binary.BigEndian.Uint16(b))
+	weight := int(int16(binary.BigEndian.Uint16(b[2:])))
+	sign := binary.BigEndian.Uint16(b[4:])
+	dscale := binary.BigEndian.Uint16(b[6:])
+	if len(b) != 8+2*ndigits {
+		return Zero(), fmt.Errorf("pg numeric: expected %d bytes for %d digits, got %d", 8+2*ndigits, ndigits, len(b))
+	}
+	var d Decimal
+	switch sign {
+	case pgNumericPositive:
+	case pgNumericNegative:
+		d.Negative = true
+	case pgNumericNaN:
+		return Zero(), fmt.Errorf("pg numeric: cannot represent NaN as decimal")
+	case pgNumericPosInf, pgNumericNegInf:
+		return Zero(), fmt.Errorf("pg numeric: cannot represent infinity as decimal")
+	default:
+		return Zero(), fmt.Errorf("pg numeric: invalid sign 0x%04x", sign)
+	}
+	if dscale&0xC000 != 0 {
+		return Zero(), fmt.Errorf("pg numeric: invalid display scale 0x%04x", dscale)
+	}
+
+	// The fraction is accumulated with 19 digits and reduced to the display scale afterwards.
+	var fraction uint64
+	for i := range ndigits {
+		g := uint64(binary.BigEndian.Uint16(b[8+2*i:]))
+		if g >= pgNumericBase {
+			return Zero(), fmt.Errorf("pg numeric: invalid digit %d", g)
+		}
+		if g == 0 {
+			continue
+		}
+		switch w := weight - i; {
+		case w > 4:
+			return Zero(), fmt.Errorf("pg numeric: value overflows unsigned 64-bit integer")
+		case w >= 0:
+			hi, lo := bits.Mul64(g, pow10[4*w])
+			var carry uint64
+			d.Integer, carry = bits.Add64(d.Integer, lo, 0)
+			if hi != 0 || carry != 0 {
+				return Zero(), fmt.Errorf("pg numeric: value overflows unsigned 64-bit integer")
+			}
+		case w >= -4:
+			fraction += g * pow10[19+4*w]
+		case w == -5 && g%10 == 0:
+			fraction += g / 10
+		default:
+			return Zero(), fmt.Errorf("pg numeric: more digits in fraction than can be represented")
+		}
+	}
+	d.Digits = uint8(min(dscale, 19))
+	if fraction%pow10[19-d.Digits] != 0 {
+		return Zero(), fmt.Errorf("pg numeric: more digits in fraction than the display scale %d", dscale)
+	}
+	d.Fraction = fraction / pow10[19-d.Digits]
+	if d.Integer == 0 && d.Fraction == 0 {
+		d.Negative = false
+	}
+	return d, nil
+}
+
+// AppendPgNumeric appends the fixed-point value in the binary format of the PostgreSQL NUMERIC type
+// with a display scale of 2 to dst and returns the extended buffer.
+func (f Fixed) AppendPgNumeric(dst []byte) []byte {
+	return f.Decimal().AppendPgNumeric(dst)
+}
+
+// DecodeFixedPgNumeric decodes a value in the binary format of the PostgreSQL NUMERIC type as a fixed-point value.
+// It follows the rules of `DecodePgNumeric` and rejects values that cannot be represented exactly.
+func DecodeFixedPgNumeric(b []byte) (Fixed, error) {
+	d, err := DecodePgNumeric(b)
+	if err != nil {
+		return 0, err
+	}
+	return fixedFromDecimal(d)
+}
@@ -0,0 +1,111 @@
+package decimal_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestDecimal_AppendPgNumeric(t *testing.T) {
+	tests := []struct {
+		name string
+		d    decimal.Decimal
+		hex  string
+	}{
+		{"zero", decimal.Decimal{}, "0000000000000000"},
+		{"zero_with_scale", decimal.Decimal{Digits: 2}, "0000000000000002"},
+		{"integer_and_fraction", decimal.Decimal{Integer: 1234, Fraction: 5678, Digits: 4}, "00020000000000040" + "4d2162e"},
+		{"partial_fraction_group", decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, "00020000000000010" + "00c1388"},
+		{"trailing_zeros_in_scale", decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}, "00020000000000020" + "00c1388"},
+		{"small_negative", decimal.Decimal{Negative: true, Fraction: 1, Digits: 4}, "0001ffff400000040001"},
+		{"trailing_zero_group", decimal.Decimal{Integer: 10000}, "00010001000000000001"},
+		{"max_uint64", decimal.Decimal{Integer: 18446744073709551615}, "0005000400000000073" + "41a5802e103bb064f"},
+		{"max_digits", decimal.Decimal{Fraction: 1, Digits: 19}, "0001fffb00000013000a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.AppendPgNumeric(nil)
+			if hex.EncodeToString(got) != tt.hex {
+				t.Errorf("AppendPgNumeric() = %x, want %s", got, tt.hex)
+			}
+			back, err := decimal.DecodePgNumeric(got)
+			if err != nil {
+				t.Fatalf("DecodePgNumeric(%x) error = %v", got, err)
+			}
+			if back != tt.d {
+				t.Errorf("DecodePgNumeric(%x) = %#v, want %#v", got, back, tt.d)
+			}
+		})
+	}
+}
+
+func TestDecodePgNumeric(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    decimal.Decimal
+		wantErr bool
+	}{
+		{"scale_above_19", "00020000000000190" + "0011388", decimal.Decimal{Integer: 1, Fraction: 5000000000000000000, Digits: 19}, false},
+		{"negative_zero", "0000000040000002", decimal.Decimal{Digits: 2}, false},
+		{"nan", "00000000c0000000", decimal.Decimal{}, true},
+		{"positive_infinity", "00000000d0000000", decimal.Decimal{}, true},
+		{"negative_infinity", "00000000f0000000", decimal.Decimal{}, true},
+		{"invalid_sign", "0000000012340000", decimal.Decimal{}, true},
+		{"short_header", "000000000000", decimal.Decimal{}, true},
+		{"missing_digits", "00020000000000000001", decimal.Decimal{}, true},
+		{"trailing_data", "000100000000000000010000", decimal.Decimal{}, true},
+		{"invalid_digit", "0001000000000000" + "2710", decimal.Decimal{}, true},
+		{"integer_overflow", "0001000500000000" + "0001", decimal.Decimal{}, true},
+		{"integer_overflow_in_top_group", "0001000400000000" + "0735", decimal.Decimal{}, true},
+		{"too_many_fractional_digits", "0001fffb00000014" + "0001", decimal.Decimal{}, true},
+		{"digits_beyond_scale", "00010000000000000" + "001" + "", decimal.Decimal{Integer: 1}, false},
+		{"fraction_beyond_scale", "0001ffff000000010001", decimal.Decimal{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt.hex)
+			got, err := decimal.DecodePgNumeric(b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodePgNumeric(%s) error = %v, wantErr %v", tt.hex, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DecodePgNumeric(%s) = %#v, want %#v", tt.hex, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal_AppendPgNumeric(b *testing.B) {
+	d := decimal.Decimal{Negative: true, Integer: 123456789, Fraction: 123456, Digits: 6}
+	buf := make([]byte, 0, 32)
+	for b.Loop() {
+		buf = d.AppendPgNumeric(buf[:0])
+	}
+}
+
+func BenchmarkDecodePgNumeric(b *testing.B) {
+	data := decimal.Decimal{Negative: true, Integer: 123456789, Fraction: 123456, Digits: 6}.AppendPgNumeric(nil)
+	for b.Loop() {
+		_, _ = decimal.DecodePgNumeric(data)
+	}
+}
+
+func TestFixed_PgNumeric(t *testing.T) {
+	for _, f := range []decimal.Fixed{0, 1, -1250, 2147483647, -2147483648} {
+		data := f.AppendPgNumeric(nil)
+		if hex.EncodeToString(data[6:8]) != "0002" {
+			t.Errorf("AppendPgNumeric(%d) = %x, want display scale 2", int32(f), data)
+		}
+		got, err := decimal.DecodeFixedPgNumeric(data)
+		if err != nil || got != f {
+			t.Errorf("DecodeFixedPgNumeric(%x) = %d, %v, want %d", data, int32(got), err, int32(f))
+		}
+	}
+	for _, d := range []decimal.Decimal{{Fraction: 125, Digits: 3}, {Integer: 21474837}} {
+		if got, err := decimal.DecodeFixedPgNumeric(d.AppendPgNumeric(nil)); err == nil {
+			t.Errorf("DecodeFixedPgNumeric(%v) = %d, want error", d, int32(got))
+		}
+	}
+}
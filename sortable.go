@@ -0,0 +1,147 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// The sortable key of a Decimal is the byte 0x80 for zero.
+// Other values encode their magnitude as a header byte 0x81+n followed by the n bytes of the integer in big-endian order
+// without leading zero bytes and the fraction without trailing zeros.
+// The fraction is a single byte 0x00 if it is zero, otherwise each pair of digits p (0-99, the last one padded with a zero)
+// is encoded as 2p+1, except for the last pair which is encoded as 2p.
+// Negative values use the bitwise complement of the encoded magnitude, so they sort below zero and in reverse order of magnitude.
+// The magnitude encoding is prefix-free which keeps the order intact when keys are followed by other data.
+const (
+	sortableZero       = 0x80
+	sortableHeader     = 0x81
+	fixedSortableFlip  = 0x80000000
+	fixedSortableBytes = 4
+)
+
+// AppendSortableKey appends an order-preserving encoding of the decimal value to dst and returns the extended buffer.
+// Comparing keys with bytes.Compare yields the same result as `Compare` on the values.
+// The encoding is variable length and independent of the scale, so 0.1 and 0.10 have identical keys.
+func (d Decimal) AppendSortableKey(dst []byte) []byte {
+	d = d.Truncate()
+	if d.Integer == 0 && d.Fraction == 0 {
+		return append(dst, sortableZero)
+	}
+	start := len(dst)
+	n := 8 - bits.LeadingZeros64(d.Integer)/8
+	dst = append(dst, sortableHeader+byte(n))
+	var arr [8]byte
+	binary.BigEndian.PutUint64(arr[:], d.Integer)
+	dst = append(dst, arr[8-n:]...)
+	if d.Digits == 0 {
+		dst = append(dst, 0x00)
+	}
+	for n := int(d.Digits); n > 0; n -= 2 {
+		var p uint64
+		if n >= 2 {
+			p = d.Fraction / pow10[n-2] % 100
+		} else {
+			p = d.Fraction % 10 * 10
+		}
+		b := byte(2*p + 1)
+		if n <= 2 {
+			b--
+		}
+		dst = append(dst, b)
+	}
+	if d.Negative {
+		for i := start; i < len(dst); i++ {
+			dst[i] = ^dst[i]
+		}
+	}
+	return dst
+}
+
+// DecodeSortableKey decodes a key produced by `AppendSortableKey` from the start of b.
+// It returns the value without trailing zeros and the number of bytes consumed, so keys can be followed by other data.
+// Keys that are not in the canonical encoding are rejected.
+func DecodeSortableKey(b []byte) (Decimal, int, error) {
+	if len(b) == 0 {
+		return Zero(), 0, fmt.Errorf("sortable key: no data")
+	}
+	if b[0] == sortableZero {
+		return Zero(), 1, nil
+	}
+	var d Decimal
+	var flip byte
+	if b[0] < sortableZero {
+		d.Negative = true
+		flip = 0xff
+	}
+	header := b[0] ^ flip
+	if header < sortableHeader || header > sortableHeader+8 {
+		return Zero(), 0, fmt.Errorf("sortable key: invalid header 0x%02x", b[0])
+	}
+	n := int(header - sortableHeader)
+	if len(b) < n+2 {
+		return Zero(), 0, fmt.Errorf("sortable key: not enough data for %d integer bytes", n)
+	}
+	for i := range n {
+		c := b[1+i] ^ flip
+		if i == 0 && c == 0 {
+			return Zero(), 0, fmt.Errorf("sortable key: leading zero byte in integer")
+		}
+		d.Integer = d.Integer<<8 | uint64(c)
+	}
+	pos := 1 + n
+	if b[pos]^flip == 0x00 {
+		if d.Integer == 0 {
+			return Zero(), 0, fmt.Errorf("sortable key: non-canonical zero")
+		}
+		return d, pos + 1, nil
+	}
+	for {
+		if pos >= len(b) {
+			return Zero(), 0, fmt.Errorf("sortable key: unterminated fraction")
+		}
+		c := b[pos] ^ flip
+		pos++
+		p := uint64(c >> 1)
+		last := c&1 == 0
+		if p > 99 {
+			return Zero(), 0, fmt.Errorf("sortable key: invalid fraction byte 0x%02x", b[pos-1])
+		}
+		if last && p == 0 {
+			return Zero(), 0, fmt.Errorf("sortable key: trailing zeros in fraction")
+		}
+		if d.Digits == 18 {
+			if !last || p%10 != 0 {
+				return Zero(), 0, fmt.Errorf("sortable key: more digits in fraction than can be represented")
+			}
+			d.Fraction = d.Fraction*10 + p/10
+			d.Digits = 19
+			return d, pos, nil
+		}
+		d.Fraction = d.Fraction*100 + p
+		d.Digits += 2
+		if last {
+			if p%10 == 0 {
+				d.Fraction /= 10
+				d.Digits--
+			}
+			return d, pos, nil
+		}
+	}
+}
+
+// AppendSortableKey appends an order-preserving encoding of the fixed-point value to dst and returns the extended buffer.
+// The key is the value as a big-endian 32-bit integer with the sign bit flipped, so comparing keys with bytes.Compare
+// yields the same result as comparing the values.
+func (f Fixed) AppendSortableKey(dst []byte) []byte {
+	return binary.BigEndian.AppendUint32(dst, uint32(f)^fixedSortableFlip)
+}
+
+// DecodeFixedSortableKey decodes a key produced by `Fixed.AppendSortableKey` from the start of b.
+// It returns the value and the number of bytes consumed, which is always 4.
+func DecodeFixedSortableKey(b []byte) (Fixed, int, error) {
+	if len(b) < fixedSortableBytes {
+		return 0, 0, fmt.Errorf("sortable key: not enough data for fixed-point value: %d bytes", len(b))
+	}
+	return Fixed(binary.BigEndian.Uint32(b) ^ fixedSortableFlip), fixedSortableBytes, nil
+}
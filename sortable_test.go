@@ -0,0 +1,174 @@
+package decimal_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/fossoreslp/decimal"
+)
+
+func TestDecimal_AppendSortableKey(t *testing.T) {
+	tests := []struct {
+		name string
+		d    decimal.Decimal
+		hex  string
+	}{
+		{"zero", decimal.Decimal{}, "80"},
+		{"zero_with_digits", decimal.Decimal{Digits: 3}, "80"},
+		{"one", decimal.Decimal{Integer: 1}, "820100"},
+		{"negative_one", decimal.Decimal{Negative: true, Integer: 1}, "7dfeff"},
+		{"integer_two_bytes", decimal.Decimal{Integer: 300}, "83012c00"},
+		{"tenth", decimal.Decimal{Fraction: 1, Digits: 1}, "8114"},
+		{"tenth_with_trailing_zeros", decimal.Decimal{Fraction: 100, Digits: 3}, "8114"},
+		{"hundredth", decimal.Decimal{Fraction: 1, Digits: 2}, "8102"},
+		{"three_digits", decimal.Decimal{Integer: 12, Fraction: 345, Digits: 3}, "820c4564"},
+		{"negative_fraction", decimal.Decimal{Negative: true, Fraction: 5, Digits: 1}, "7e9b"},
+		{"max_digits", decimal.Decimal{Fraction: 1, Digits: 19}, "8101010101010101010114"},
+		{"max", decimal.Decimal{Integer: 18446744073709551615, Fraction: 9999999999999999999, Digits: 19}, "89ffffffffffffffffc7c7c7c7c7c7c7c7c7b4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.AppendSortableKey(nil)
+			if hex.EncodeToString(got) != tt.hex {
+				t.Errorf("AppendSortableKey() = %x, want %s", got, tt.hex)
+			}
+			back, n, err := decimal.DecodeSortableKey(append(got, 0xff))
+			if err != nil {
+				t.Fatalf("DecodeSortableKey(%x) error = %v", got, err)
+			}
+			if n != len(got) || back != tt.d.Truncate() {
+				t.Errorf("DecodeSortableKey(%x) = %#v, %d, want %#v, %d", got, back, n, tt.d.Truncate(), len(got))
+			}
+		})
+	}
+}
+
+func TestDecodeSortableKey_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+	}{
+		{"empty", ""},
+		{"invalid_header", "8a"},
+		{"missing_integer", "8301"},
+		{"leading_zero_byte", "8300010000"},
+		{"non_canonical_zero", "8100"},
+		{"unterminated_fraction", "8103"},
+		{"trailing_zero_pair", "810300"},
+		{"pair_out_of_range", "81c8"},
+		{"too_many_digits", "810101010101010101010102"},
+		{"twenty_digits", "8101010101010101010102"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt.hex)
+			if d, _, err := decimal.DecodeSortableKey(b); err == nil {
+				t.Errorf("DecodeSortableKey(%s) = %#v, want error", tt.hex, d)
+			}
+		})
+	}
+}
+
+func randomDecimal(r *rand.Rand) decimal.Decimal {
+	d := decimal.Decimal{Negative: r.IntN(2) == 0, Digits: uint8(r.IntN(20))}
+	// Favor small magnitudes and shared prefixes to exercise ties and near-ties.
+	switch r.IntN(3) {
+	case 0:
+		d.Integer = r.Uint64N(3)
+	case 1:
+		d.Integer = r.Uint64N(1000)
+	default:
+		d.Integer = r.Uint64() >> r.IntN(64)
+	}
+	if d.Digits > 0 {
+		d.Fraction = r.Uint64N(pow10(d.Digits))
+		zeros := pow10(uint8(r.IntN(int(d.Digits) + 1)))
+		d.Fraction = d.Fraction / zeros * zeros
+	}
+	if d.Integer == 0 && d.Fraction == 0 {
+		d.Negative = false
+	}
+	return d
+}
+
+func pow10(n uint8) uint64 {
+	p := uint64(1)
+	for range n {
+		p *= 10
+	}
+	return p
+}
+
+func TestDecimal_SortableKeyOrder(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	values := make([]decimal.Decimal, 2000)
+	for i := range values {
+		values[i] = randomDecimal(r)
+	}
+	for i := 1; i < len(values); i++ {
+		a, b := values[i-1], values[i]
+		ka, kb := a.AppendSortableKey(nil), b.AppendSortableKey(nil)
+		if got, want := bytes.Compare(ka, kb), decimal.Compare(a, b); got != want {
+			t.Fatalf("bytes.Compare(%x, %x) = %d, Compare(%v, %v) = %d", ka, kb, got, a, b, want)
+		}
+	}
+
+	keys := make([][]byte, len(values))
+	for i, v := range values {
+		keys[i] = v.AppendSortableKey(nil)
+	}
+	slices.SortFunc(keys, bytes.Compare)
+	slices.SortFunc(values, decimal.Compare)
+	for i, k := range keys {
+		got, _, err := decimal.DecodeSortableKey(k)
+		if err != nil {
+			t.Fatalf("DecodeSortableKey(%x) error = %v", k, err)
+		}
+		if !decimal.Equal(got, values[i]) {
+			t.Fatalf("sorted key %d = %v, sorted value = %v", i, got, values[i])
+		}
+	}
+}
+
+func BenchmarkDecimal_AppendSortableKey(b *testing.B) {
+	d := decimal.Decimal{Negative: true, Integer: 123, Fraction: 456789, Digits: 6}
+	buf := make([]byte, 0, 32)
+	for b.Loop() {
+		buf = d.AppendSortableKey(buf[:0])
+	}
+}
+
+func BenchmarkDecodeSortableKey(b *testing.B) {
+	key := decimal.Decimal{Negative: true, Integer: 123, Fraction: 456789, Digits: 6}.AppendSortableKey(nil)
+	for b.Loop() {
+		_, _, _ = decimal.DecodeSortableKey(key)
+	}
+}
+
+func TestFixed_AppendSortableKey(t *testing.T) {
+	values := []decimal.Fixed{-2147483648, -12345, -1, 0, 1, 99, 100, 12345, 2147483647}
+	var prev []byte
+	for _, f := range values {
+		key := f.AppendSortableKey(nil)
+		if len(key) != 4 {
+			t.Fatalf("AppendSortableKey(%d) = %x, want 4 bytes", int32(f), key)
+		}
+		if prev != nil && bytes.Compare(prev, key) >= 0 {
+			t.Errorf("AppendSortableKey(%d) = %x does not sort after %x", int32(f), key, prev)
+		}
+		back, n, err := decimal.DecodeFixedSortableKey(key)
+		if err != nil || n != 4 || back != f {
+			t.Errorf("DecodeFixedSortableKey(%x) = %d, %d, %v, want %d", key, int32(back), n, err, int32(f))
+		}
+		prev = key
+	}
+	if got := hex.EncodeToString(decimal.Fixed(0).AppendSortableKey(nil)); got != "80000000" {
+		t.Errorf("AppendSortableKey(0) = %s, want 80000000", got)
+	}
+	if _, _, err := decimal.DecodeFixedSortableKey([]byte{0x80, 0, 0}); err == nil {
+		t.Errorf("DecodeFixedSortableKey(800000) succeeded, want error")
+	}
+}
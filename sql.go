@@ -1,15 +1,26 @@
 package decimal
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
 	"unsafe"
 )
 
 // Scan converts SQL data into a decimal value.
-// It handles textual representation as well as floating point and integer values.
-// Decoding follows the standards set by `New` and `NewFromString` respectively.
+// It handles textual representation including `json.Number`, floating point and integer values of any width,
+// `Decimal` and `Fixed` values as well as `*big.Int`, `*big.Rat` and `*big.Float`.
+// Text follows `NewFromString`, additionally ignoring surrounding whitespace such as the padding of CHAR columns
+// and accepting an exponent as in "1E+5". 64-bit floating point values follow `New`, 32-bit floating point values
+// are converted from their shortest decimal representation. `*big.Int` and `*big.Rat` values must be representable exactly,
+// `*big.Float` values are converted from their shortest decimal representation.
 func (d *Decimal) Scan(value any) (err error) {
 	if value == nil {
 		*d = Zero()
@@ -17,14 +28,21 @@ func (d *Decimal) Scan(value any) (err error) {
 	}
 	switch v := value.(type) {
 	case []byte:
-		val, err := NewFromString(unsafe.String(unsafe.SliceData(v), len(v)))
+		val, err := parseSQL(unsafe.String(unsafe.SliceData(v), len(v)))
 		if err != nil {
 			return err
 		}
 		*d = val
 		return nil
 	case string:
-		val, err := NewFromString(v)
+		val, err := parseSQL(v)
+		if err != nil {
+			return err
+		}
+		*d = val
+		return nil
+	case json.Number:
+		val, err := NewFromJSONNumber(v)
 		if err != nil {
 			return err
 		}
@@ -33,6 +51,13 @@ func (d *Decimal) Scan(value any) (err error) {
 	case float64:
 		*d = New(v)
 		return nil
+	case float32:
+		val, err := parseFloat32(v)
+		if err != nil {
+			return err
+		}
+		*d = val
+		return nil
 	case int64:
 		if v < 0 {
 			d.Negative = true
@@ -50,6 +75,43 @@ func (d *Decimal) Scan(value any) (err error) {
 		d.Fraction = 0
 		d.Digits = 0
 		return nil
+	case int:
+		*d = New(v)
+		return nil
+	case int8:
+		*d = New(v)
+		return nil
+	case int16:
+		*d = New(v)
+		return nil
+	case int32:
+		*d = New(v)
+		return nil
+	case uint:
+		*d = New(v)
+		return nil
+	case uint8:
+		*d = New(v)
+		return nil
+	case uint16:
+		*d = New(v)
+		return nil
+	case uint32:
+		*d = New(v)
+		return nil
+	case Decimal:
+		*d = v
+		return nil
+	case Fixed:
+		*d = v.Decimal()
+		return nil
+	case *big.Int, *big.Rat, *big.Float:
+		val, err := parseBig(v)
+		if err != nil {
+			return err
+		}
+		*d = val
+		return nil
 	default:
 		return fmt.Errorf("invalid type for Decimal: %T", value)
 	}
@@ -62,8 +124,9 @@ func (d Decimal) Value() (driver.Value, error) {
 }
 
 // Scan converts SQL data into a fixed-point value.
-// It handles textual representation as well as floating point and integer values.
-// Textual representation follows the semantics of NewFixedFromString.
+// It accepts the same types as `Decimal.Scan`.
+// Textual representation follows the semantics of NewFixedFromString, additionally ignoring surrounding whitespace
+// and accepting an exponent as in "1.5E+2".
 // Floating point errors are accounted for while genuine sub-hundredth precision is rejected.
 // Integer values are taken exactly. Values outside the fixed-point range are rejected.
 func (f *Fixed) Scan(value any) (err error) {
@@ -73,14 +136,21 @@ func (f *Fixed) Scan(value any) (err error) {
 	}
 	switch v := value.(type) {
 	case []byte:
-		val, err := NewFixedFromString(unsafe.String(unsafe.SliceData(v), len(v)))
+		val, err := parseFixedSQL(unsafe.String(unsafe.SliceData(v), len(v)))
 		if err != nil {
 			return err
 		}
 		*f = val
 		return nil
 	case string:
-		val, err := NewFixedFromString(v)
+		val, err := parseFixedSQL(v)
+		if err != nil {
+			return err
+		}
+		*f = val
+		return nil
+	case json.Number:
+		val, err := NewFixedFromJSONNumber(v)
 		if err != nil {
 			return err
 		}
@@ -115,6 +185,36 @@ func (f *Fixed) Scan(value any) (err error) {
 		}
 		*f = Fixed(v * 100)
 		return nil
+	case int:
+		return f.Scan(int64(v))
+	case int8:
+		return f.Scan(int64(v))
+	case int16:
+		return f.Scan(int64(v))
+	case int32:
+		return f.Scan(int64(v))
+	case uint:
+		return f.Scan(uint64(v))
+	case uint8:
+		return f.Scan(uint64(v))
+	case uint16:
+		return f.Scan(uint64(v))
+	case uint32:
+		return f.Scan(uint64(v))
+	case Fixed:
+		*f = v
+		return nil
+	case float32, Decimal, *big.Int, *big.Rat, *big.Float:
+		var d Decimal
+		if err := d.Scan(v); err != nil {
+			return err
+		}
+		val, err := fixedFromDecimal(d)
+		if err != nil {
+			return err
+		}
+		*f = val
+		return nil
 	default:
 		return fmt.Errorf("invalid type for Fixed: %T", value)
 	}
@@ -125,3 +225,246 @@ func (f *Fixed) Scan(value any) (err error) {
 func (f Fixed) Value() (driver.Value, error) {
 	return f.String(), nil
 }
+
+// parseSQL parses the textual forms of numbers returned by database drivers.
+// In addition to the format accepted by `NewFromString`, surrounding whitespace such as the padding of CHAR columns
+// is ignored and the number may be followed by an exponent as in "1E+5" or "2.5e-3".
+func parseSQL(s string) (Decimal, error) {
+	return parseExponent(strings.TrimSpace(s))
+}
+
+// maxExponent bounds the exponents accepted by `parseExponent`. A 20-digit integer part or 19 fractional digits
+// can absorb at most 39 digits of shift, so larger exponents can never be represented.
+const maxExponent = 40
+
+// parseExponent parses a number in the format accepted by `NewFromString`, optionally followed by an exponent.
+func parseExponent(s string) (Decimal, error) {
+	i := strings.IndexAny(s, "eE")
+	if i < 0 {
+		return NewFromString(s)
+	}
+	d, err := NewFromString(s[:i])
+	if err != nil {
+		return Zero(), err
+	}
+	exp, err := strconv.ParseInt(s[i+1:], 10, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return Zero(), fmt.Errorf("invalid exponent: %s", s)
+	}
+	if exp < -maxExponent || exp > maxExponent {
+		return Zero(), fmt.Errorf("exponent out of range: %s", s)
+	}
+	return d.shift(int(exp))
+}
+
+// parseFixedSQL parses the textual forms of numbers returned by database drivers as described by `parseSQL`.
+func parseFixedSQL(s string) (Fixed, error) {
+	s = strings.TrimSpace(s)
+	if !strings.ContainsAny(s, "eE") {
+		return NewFixedFromString(s)
+	}
+	d, err := parseSQL(s)
+	if err != nil {
+		return 0, err
+	}
+	return fixedFromDecimal(d)
+}
+
+// shift multiplies the value by 10^exp, failing if the result cannot be represented.
+// The number of fractional digits is adjusted by the exponent, trailing zeros are only removed if necessary.
+// The exponent must be within ±maxExponent so it can be negated and compared without overflow.
+func (d Decimal) shift(exp int) (Decimal, error) {
+	if d.Integer == 0 && d.Fraction == 0 {
+		return Zero(), nil
+	}
+	if exp < 0 {
+		if int(d.Digits)-exp > 19 {
+			d = d.Truncate()
+		}
+		n := -exp
+		// Without a fraction, trailing zeros of the integer absorb the part of the shift that does not fit, e.g. 100e-20 is 0.0000000000000000010.
+		for d.Digits == 0 && n > 19 && d.Integer%10 == 0 {
+			d.Integer /= 10
+			n--
+		}
+		if int(d.Digits)+n > 19 {
+			return Zero(), fmt.Errorf("more digits in fraction than can be represented: %d", int(d.Digits)+n)
+		}
+		d.Fraction += d.Integer % pow10[n] * pow10[d.Digits]
+		d.Integer /= pow10[n]
+		d.Digits += uint8(n)
+		return d, nil
+	}
+	// Move up to exp digits from the fraction into the integer, then scale by the remaining power of ten.
+	m := min(exp, int(d.Digits))
+	rest := d.Digits - uint8(m)
+	hi, lo := bits.Mul64(d.Integer, pow10[m])
+	lo, carry := bits.Add64(lo, d.Fraction/pow10[rest], 0)
+	if hi != 0 || carry != 0 {
+		return Zero(), fmt.Errorf("value overflows unsigned 64-bit integer")
+	}
+	if exp -= m; exp > 0 {
+		if exp >= len(pow10) {
+			return Zero(), fmt.Errorf("value overflows unsigned 64-bit integer")
+		}
+		if hi, lo = bits.Mul64(lo, pow10[exp]); hi != 0 {
+			return Zero(), fmt.Errorf("value overflows unsigned 64-bit integer")
+		}
+	}
+	d.Integer = lo
+	d.Fraction %= pow10[rest]
+	d.Digits = rest
+	return d, nil
+}
+
+// parseFloat32 converts a 32-bit floating point number using its shortest decimal representation,
+// so values such as float32(0.1) are not affected by the binary approximation.
+// Representations with more than 19 fractional digits are rounded to 19 digits.
+func parseFloat32(v float32) (Decimal, error) {
+	if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+		return Zero(), fmt.Errorf("value out of range: %v", v)
+	}
+	var arr [64]byte
+	b := strconv.AppendFloat(arr[:0], float64(v), 'f', -1, 32)
+	if i := bytes.IndexByte(b, '.'); i >= 0 && len(b)-i-1 > 19 {
+		b = strconv.AppendFloat(arr[:0], float64(v), 'f', 19, 32)
+	}
+	d, err := NewFromString(unsafe.String(unsafe.SliceData(b), len(b)))
+	if err != nil {
+		return Zero(), err
+	}
+	return d.Truncate(), nil
+}
+
+// parseBig converts a value from math/big, failing if it cannot be represented.
+// Integers and rationals are converted exactly, floats use their shortest decimal representation.
+func parseBig(value any) (Decimal, error) {
+	var arr [64]byte
+	var b []byte
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return Zero(), fmt.Errorf("invalid nil *big.Int")
+		}
+		b = v.Append(arr[:0], 10)
+	case *big.Rat:
+		if v == nil {
+			return Zero(), fmt.Errorf("invalid nil *big.Rat")
+		}
+		n, exact := v.FloatPrec()
+		if !exact {
+			return Zero(), fmt.Errorf("value has no finite decimal representation: %s", v)
+		}
+		b = append(arr[:0], v.FloatString(n)...)
+	case *big.Float:
+		if v == nil {
+			return Zero(), fmt.Errorf("invalid nil *big.Float")
+		}
+		if v.IsInf() {
+			return Zero(), fmt.Errorf("value out of range: %s", v)
+		}
+		b = v.Append(arr[:0], 'f', -1)
+	}
+	return NewFromString(unsafe.String(unsafe.SliceData(b), len(b)))
+}
+
+// Cents stores a fixed-point value in SQL as an integer number of hundredths, e.g. in a BIGINT column.
+// Convert values with `Cents(f)` when writing and scan into `(*Cents)(&f)` when reading.
+type Cents Fixed
+
+// Scan converts an SQL integer of hundredths into a fixed-point value.
+// It accepts integer types of any width as well as their textual representation including `json.Number`.
+// `nil` resets the receiver to zero. Values outside the fixed-point range are rejected.
+func (c *Cents) Scan(value any) error {
+	var v int64
+	switch x := value.(type) {
+	case nil:
+	case int64:
+		v = x
+	case int:
+		v = int64(x)
+	case int8:
+		v = int64(x)
+	case int16:
+		v = int64(x)
+	case int32:
+		v = int64(x)
+	case uint:
+		if uint64(x) > math.MaxInt32 {
+			return fmt.Errorf("value out of range: %d", x)
+		}
+		v = int64(x)
+	case uint8:
+		v = int64(x)
+	case uint16:
+		v = int64(x)
+	case uint32:
+		v = int64(x)
+	case uint64:
+		if x > math.MaxInt32 {
+			return fmt.Errorf("value out of range: %d", x)
+		}
+		v = int64(x)
+	case []byte:
+		return c.Scan(unsafe.String(unsafe.SliceData(x), len(x)))
+	case json.Number:
+		return c.Scan(string(x))
+	case string:
+		val, err := strconv.ParseInt(strings.TrimSpace(x), 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid integer for Cents: %w", err)
+		}
+		v = val
+	default:
+		return fmt.Errorf("invalid type for Cents: %T", value)
+	}
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return fmt.Errorf("value out of range: %d", v)
+	}
+	*c = Cents(v)
+	return nil
+}
+
+// Value encodes a fixed-point value for SQL as an int64 number of hundredths.
+func (c Cents) Value() (driver.Value, error) {
+	return int64(c), nil
+}
+
+// NativeDecimal stores a decimal value in SQL using a native integer where possible.
+// Convert values with `NativeDecimal(d)` when writing and scan into `(*NativeDecimal)(&d)` when reading.
+type NativeDecimal Decimal
+
+// Scan converts SQL data into a decimal value as described by `Decimal.Scan`.
+func (n *NativeDecimal) Scan(value any) error {
+	return (*Decimal)(n).Scan(value)
+}
+
+// Value encodes a decimal value for SQL.
+// Values without fractional digits that fit into an int64 are encoded as int64, all other values use
+// the string representation of `Decimal.Value`. Trailing zeros are significant, so `1.0` is encoded as a string.
+func (n NativeDecimal) Value() (driver.Value, error) {
+	if n.Digits == 0 {
+		if !n.Negative && n.Integer <= math.MaxInt64 {
+			return int64(n.Integer), nil
+		}
+		if n.Negative && n.Integer <= 1<<63 {
+			return -int64(n.Integer), nil // wraps correctly for math.MinInt64
+		}
+	}
+	return Decimal(n).Value()
+}
+
+// FloatDecimal stores a decimal value in SQL as a 64-bit floating point number, e.g. in a DOUBLE PRECISION column.
+// The conversion to float64 may lose precision, so it should only be used where the schema requires it.
+// Convert values with `FloatDecimal(d)` when writing and scan into `(*FloatDecimal)(&d)` when reading.
+type FloatDecimal Decimal
+
+// Scan converts SQL data into a decimal value as described by `Decimal.Scan`.
+func (f *FloatDecimal) Scan(value any) error {
+	return (*Decimal)(f).Scan(value)
+}
+
+// Value encodes a decimal value for SQL as float64 using `Decimal.Float64`.
+func (f FloatDecimal) Value() (driver.Value, error) {
+	return Decimal(f).Float64(), nil
+}
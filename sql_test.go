@@ -1,8 +1,11 @@
 package decimal_test
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"math"
+	"math/big"
 	"testing"
 
 	"github.com/fossoreslp/decimal"
@@ -33,6 +36,62 @@ func TestDecimal_Scan(t *testing.T) {
 		{"int64_clears_negative", int64(5), negative, decimal.Decimal{Integer: 5}, false},
 		{"uint64", uint64(123), sentinel, decimal.Decimal{Integer: 123}, false},
 		{"uint64_clears_negative", uint64(5), negative, decimal.Decimal{Integer: 5}, false},
+		{"string_padded", " 12.50  ", sentinel, decimal.Decimal{Integer: 12, Fraction: 50, Digits: 2}, false},
+		{"bytes_padded", []byte("\t-3\n"), sentinel, decimal.Decimal{Negative: true, Integer: 3}, false},
+		{"string_leading_dot", ".5", sentinel, decimal.Decimal{Fraction: 5, Digits: 1}, false},
+		{"string_leading_dot_negative", "-.5", sentinel, decimal.Decimal{Negative: true, Fraction: 5, Digits: 1}, false},
+		{"string_exponent", "1E+5", sentinel, decimal.Decimal{Integer: 100000}, false},
+		{"string_exponent_lowercase", "1.25e1", sentinel, decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, false},
+		{"string_exponent_unsigned", "1.5E2", sentinel, decimal.Decimal{Integer: 150}, false},
+		{"string_exponent_negative", "-2.5E-3", sentinel, decimal.Decimal{Negative: true, Fraction: 25, Digits: 4}, false},
+		{"string_exponent_zero", "-0E+10", sentinel, decimal.Decimal{}, false},
+		{"string_exponent_trailing_zeros", "1.000E-18", sentinel, decimal.Decimal{Fraction: 1, Digits: 18}, false},
+		{"string_exponent_maxuint64", "1.8446744073709551615E19", sentinel, decimal.Decimal{Integer: 18446744073709551615}, false},
+		{"string_exponent_overflow", "2E+19", sentinel, sentinel, true},
+		{"string_exponent_overflow_large", "1E+400", sentinel, sentinel, true},
+		{"string_exponent_too_small", "1E-20", sentinel, sentinel, true},
+		{"string_exponent_keeps_scale", "100e-2", sentinel, decimal.Decimal{Integer: 1, Digits: 2}, false},
+		{"string_exponent_integer_zeros", "100e-20", sentinel, decimal.Decimal{Fraction: 10, Digits: 19}, false},
+		{"string_exponent_integer_zeros_19_digits", "12345678901234567890e-20", sentinel, decimal.Decimal{Fraction: 1234567890123456789, Digits: 19}, false},
+		{"string_exponent_integer_zeros_fraction", "100.0e-20", sentinel, decimal.Decimal{Fraction: 10, Digits: 19}, false},
+		{"string_exponent_integer_zeros_too_small", "10e-21", sentinel, sentinel, true},
+		{"string_exponent_min_int", "1e-9223372036854775808", sentinel, sentinel, true},
+		{"bytes_exponent_min_int", []byte("1e-9223372036854775808"), sentinel, sentinel, true},
+		{"string_exponent_max_int", "1e9223372036854775807", sentinel, sentinel, true},
+		{"string_exponent_beyond_int64", "1e-99999999999999999999", sentinel, sentinel, true},
+		{"string_exponent_zero_mantissa_huge", "0e-9223372036854775808", sentinel, sentinel, true},
+		{"string_exponent_missing", "1E", sentinel, sentinel, true},
+		{"string_exponent_invalid", "1E+x", sentinel, sentinel, true},
+		{"string_exponent_no_mantissa", "E5", sentinel, sentinel, true},
+		{"int", int(-7), sentinel, decimal.Decimal{Negative: true, Integer: 7}, false},
+		{"int8", int8(-128), sentinel, decimal.Decimal{Negative: true, Integer: 128}, false},
+		{"int16", int16(300), sentinel, decimal.Decimal{Integer: 300}, false},
+		{"int32", int32(-5), sentinel, decimal.Decimal{Negative: true, Integer: 5}, false},
+		{"uint", uint(7), negative, decimal.Decimal{Integer: 7}, false},
+		{"uint8", uint8(200), sentinel, decimal.Decimal{Integer: 200}, false},
+		{"uint16", uint16(65535), sentinel, decimal.Decimal{Integer: 65535}, false},
+		{"uint32", uint32(4294967295), sentinel, decimal.Decimal{Integer: 4294967295}, false},
+		{"float32", float32(0.1), sentinel, decimal.Decimal{Fraction: 1, Digits: 1}, false},
+		{"float32_negative", float32(-123.45), sentinel, decimal.Decimal{Negative: true, Integer: 123, Fraction: 45, Digits: 2}, false},
+		{"float32_tiny", float32(-1e-30), sentinel, decimal.Decimal{}, false},
+		{"float32_rounded", float32(1.5e-19), sentinel, decimal.Decimal{Fraction: 2, Digits: 19}, false},
+		{"float32_overflow", float32(1e30), sentinel, sentinel, true},
+		{"float32_nan", float32(math.NaN()), sentinel, sentinel, true},
+		{"decimal", decimal.Decimal{Integer: 1, Fraction: 50, Digits: 2}, sentinel, decimal.Decimal{Integer: 1, Fraction: 50, Digits: 2}, false},
+		{"fixed", decimal.Fixed(-1250), sentinel, decimal.Decimal{Negative: true, Integer: 12, Fraction: 50, Digits: 2}, false},
+		{"big_int", big.NewInt(-42), sentinel, decimal.Decimal{Negative: true, Integer: 42}, false},
+		{"big_int_maxuint64", new(big.Int).SetUint64(math.MaxUint64), sentinel, decimal.Decimal{Integer: math.MaxUint64}, false},
+		{"big_int_overflow", new(big.Int).Lsh(big.NewInt(1), 64), sentinel, sentinel, true},
+		{"big_int_nil", (*big.Int)(nil), sentinel, sentinel, true},
+		{"big_rat", big.NewRat(-5, 8), sentinel, decimal.Decimal{Negative: true, Fraction: 625, Digits: 3}, false},
+		{"big_rat_integer", big.NewRat(10, 2), sentinel, decimal.Decimal{Integer: 5}, false},
+		{"big_rat_repeating", big.NewRat(1, 3), sentinel, sentinel, true},
+		{"big_rat_too_many_digits", big.NewRat(1, 1<<30), sentinel, sentinel, true},
+		{"big_float", big.NewFloat(0.1), sentinel, decimal.Decimal{Fraction: 1, Digits: 1}, false},
+		{"big_float_negative", big.NewFloat(-2.5), sentinel, decimal.Decimal{Negative: true, Integer: 2, Fraction: 5, Digits: 1}, false},
+		{"big_float_inf", big.NewFloat(math.Inf(1)), sentinel, sentinel, true},
+		{"json_number", json.Number("-1.25e1"), sentinel, decimal.Decimal{Negative: true, Integer: 12, Fraction: 5, Digits: 1}, false},
+		{"json_number_invalid", json.Number("1e"), sentinel, sentinel, true},
 		{"invalid", true, sentinel, sentinel, true},
 	}
 	for _, tt := range tests {
@@ -123,6 +182,38 @@ func TestFixed_Scan(t *testing.T) {
 		{"int64", int64(123), 0, 12300, false},
 		{"int64_negative", int64(-123), 0, -12300, false},
 		{"uint64", uint64(123), 0, 12300, false},
+		{"string_padded", " 12.50 ", 0, 1250, false},
+		{"bytes_padded", []byte("-3    "), 0, -300, false},
+		{"string_leading_dot_negative", "-.5", 0, -50, false},
+		{"string_exponent", "1.5E+2", 0, 15000, false},
+		{"string_exponent_negative", "-125E-2", 0, -125, false},
+		{"string_exponent_subcent", "1E-3", 12345, 12345, true},
+		{"string_exponent_overflow", "1E+8", 12345, 12345, true},
+		{"string_exponent_integer_zeros", "12500e-4", 0, 125, false},
+		{"string_exponent_min_int", "1e-9223372036854775808", 12345, 12345, true},
+		{"bytes_exponent_min_int", []byte("1e-9223372036854775808"), 12345, 12345, true},
+		{"int", int(-5), 0, -500, false},
+		{"int8", int8(-128), 0, -12800, false},
+		{"int16", int16(300), 0, 30000, false},
+		{"int32", int32(21474836), 0, 2147483600, false},
+		{"int32_overflow", int32(21474837), 12345, 12345, true},
+		{"uint", uint(7), 0, 700, false},
+		{"uint8", uint8(200), 0, 20000, false},
+		{"uint16", uint16(65535), 0, 6553500, false},
+		{"uint32_overflow", uint32(4294967295), 12345, 12345, true},
+		{"float32", float32(0.1), 0, 10, false},
+		{"float32_cents", float32(12.34), 0, 1234, false},
+		{"float32_subcent", float32(0.001), 12345, 12345, true},
+		{"float32_nan", float32(math.NaN()), 12345, 12345, true},
+		{"decimal", decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, 0, 150, false},
+		{"decimal_subcent", decimal.Decimal{Fraction: 125, Digits: 3}, 12345, 12345, true},
+		{"fixed", decimal.Fixed(7), 0, 7, false},
+		{"big_int", big.NewInt(12), 0, 1200, false},
+		{"big_rat", big.NewRat(-1, 4), 0, -25, false},
+		{"big_rat_repeating", big.NewRat(1, 3), 12345, 12345, true},
+		{"big_float", big.NewFloat(2.5), 0, 250, false},
+		{"json_number", json.Number("1.5e1"), 0, 1500, false},
+		{"json_number_subcent", json.Number("0.001"), 12345, 12345, true},
 		{"invalid", true, 12345, 12345, true},
 	}
 	for _, tt := range tests {
@@ -179,3 +270,116 @@ func BenchmarkFixed_Value(b *testing.B) {
 		_, _ = f.Value()
 	}
 }
+
+var (
+	_ sql.Scanner   = (*decimal.Cents)(nil)
+	_ driver.Valuer = decimal.Cents(0)
+	_ sql.Scanner   = (*decimal.NativeDecimal)(nil)
+	_ driver.Valuer = decimal.NativeDecimal{}
+	_ sql.Scanner   = (*decimal.FloatDecimal)(nil)
+	_ driver.Valuer = decimal.FloatDecimal{}
+)
+
+func TestCents_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    decimal.Fixed
+		wantErr bool
+	}{
+		{"nil", nil, 0, false},
+		{"int64", int64(1250), 1250, false},
+		{"int64_negative", int64(-5), -5, false},
+		{"int64_max", int64(math.MaxInt32), math.MaxInt32, false},
+		{"int64_min", int64(math.MinInt32), math.MinInt32, false},
+		{"int64_overflow", int64(math.MaxInt32) + 1, 12345, true},
+		{"int64_underflow", int64(math.MinInt32) - 1, 12345, true},
+		{"int32", int32(-1250), -1250, false},
+		{"uint8", uint8(99), 99, false},
+		{"uint64", uint64(1250), 1250, false},
+		{"uint64_overflow", uint64(math.MaxInt32) + 1, 12345, true},
+		{"bytes", []byte("1250"), 1250, false},
+		{"string_padded", " -1250 ", -1250, false},
+		{"json_number", json.Number("1250"), 1250, false},
+		{"string_fraction", "12.50", 12345, true},
+		{"string_overflow", "2147483648", 12345, true},
+		{"float64", 12.5, 12345, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := decimal.Fixed(12345)
+			err := (*decimal.Cents)(&f).Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Cents.Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if f != tt.want {
+				t.Errorf("Cents.Scan() = %d, want %d", int32(f), int32(tt.want))
+			}
+		})
+	}
+}
+
+func TestCents_Value(t *testing.T) {
+	for _, f := range []decimal.Fixed{0, 1250, -5, math.MaxInt32, math.MinInt32} {
+		got, err := decimal.Cents(f).Value()
+		if err != nil || got != int64(f) {
+			t.Errorf("Cents(%d).Value() = %v (%T), %v, want %d", int32(f), got, got, err, int32(f))
+		}
+	}
+}
+
+func TestNativeDecimal_Value(t *testing.T) {
+	tests := []struct {
+		name string
+		d    decimal.Decimal
+		want driver.Value
+	}{
+		{"zero", decimal.Decimal{}, int64(0)},
+		{"integer", decimal.Decimal{Integer: 123}, int64(123)},
+		{"negative", decimal.Decimal{Negative: true, Integer: 123}, int64(-123)},
+		{"max_int64", decimal.Decimal{Integer: math.MaxInt64}, int64(math.MaxInt64)},
+		{"min_int64", decimal.Decimal{Negative: true, Integer: 1 << 63}, int64(math.MinInt64)},
+		{"beyond_int64", decimal.Decimal{Integer: 1 << 63}, "9223372036854775808"},
+		{"beyond_min_int64", decimal.Decimal{Negative: true, Integer: 1<<63 + 1}, "-9223372036854775809"},
+		{"fraction", decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, "1.5"},
+		{"trailing_zero", decimal.Decimal{Integer: 1, Digits: 1}, "1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decimal.NativeDecimal(tt.d).Value()
+			if err != nil || got != tt.want {
+				t.Errorf("NativeDecimal.Value() = %v (%T), %v, want %v (%T)", got, got, err, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloatDecimal_Value(t *testing.T) {
+	tests := []struct {
+		d    decimal.Decimal
+		want float64
+	}{
+		{decimal.Decimal{}, 0},
+		{decimal.Decimal{Integer: 123}, 123},
+		{decimal.Decimal{Negative: true, Integer: 1, Fraction: 25, Digits: 2}, -1.25},
+	}
+	for _, tt := range tests {
+		got, err := decimal.FloatDecimal(tt.d).Value()
+		if err != nil || got != tt.want {
+			t.Errorf("FloatDecimal(%v).Value() = %v (%T), %v, want %v", tt.d, got, got, err, tt.want)
+		}
+	}
+}
+
+func TestNativeDecimal_Scan(t *testing.T) {
+	var d decimal.Decimal
+	if err := (*decimal.NativeDecimal)(&d).Scan(int64(-42)); err != nil || d != (decimal.Decimal{Negative: true, Integer: 42}) {
+		t.Errorf("NativeDecimal.Scan() = %v, %v", d, err)
+	}
+	if err := (*decimal.FloatDecimal)(&d).Scan(1.5); err != nil || d != (decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}) {
+		t.Errorf("FloatDecimal.Scan() = %v, %v", d, err)
+	}
+	if err := (*decimal.FloatDecimal)(&d).Scan(true); err == nil {
+		t.Error("FloatDecimal.Scan(true) expected error")
+	}
+}
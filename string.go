@@ -187,96 +187,453 @@ func (d Decimal) String() string {
 	return string(arr[pos:])
 }
 
+// AppendFormat appends the decimal value formatted according to fmt and prec to dst and returns the extended buffer.
+// The format and precision follow strconv.AppendFloat for the formats 'e', 'E', 'f', 'g' and 'G',
+// where a precision of -1 uses the exact value instead of the shortest representation. 'F' is the same as 'f'.
+// Rounding is half away from zero. Other formats append '%' followed by the format character.
+func (d Decimal) AppendFormat(dst []byte, fmt byte, prec int) []byte {
+	switch fmt {
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+	default:
+		return append(dst, '%', fmt)
+	}
+	ds := d.digits()
+	var headBuf [48]byte
+	var tailBuf [8]byte
+	head, zeros, tail := ds.format(headBuf[:0], tailBuf[:0], fmt, prec)
+	// Like for floats, negative values keep their sign when they round to zero.
+	if d.Negative && (d.Integer != 0 || d.Fraction != 0) {
+		dst = append(dst, '-')
+	}
+	dst = append(dst, head...)
+	for range zeros {
+		dst = append(dst, '0')
+	}
+	return append(dst, tail...)
+}
+
 // Format implements fmt.Formatter.
-// It supports "%f" and "%v" but not the other formats specified for floating point values such as "%e".
+// It supports the verbs "%v", "%s" and "%q" for the plain string representation, "%e", "%E", "%f", "%F", "%g" and "%G"
+// with the same precision and flags as for floating point values, and "%d" for the integer part.
+// "%d" truncates towards zero unless the "#" flag is given, in which case the value is rounded to the nearest integer.
+// A precision given for "%v" formats the value as "%g".
+// Without a precision, "%v" differs from float64 and prints the exact value with all fractional digits as `String` does,
+// e.g. "1234567.50" instead of "1.2345675e+06".
+// Negative values that round to zero keep their sign as for float64, e.g. "%.2f" formats -0.0005 as "-0.00".
+// Rounding is always half away from zero and "#v" prints the structure of the value.
 func (d Decimal) Format(state fmt.State, verb rune) {
-	pad := func(char byte, count int) {
-		if count <= 0 {
+	if verb == 'v' && state.Flag('#') {
+		writePadded(state, fmt.Sprintf("decimal.Decimal{Negative: %t, Integer: %d, Fraction: %d, Digits: %d}", d.Negative, d.Integer, d.Fraction, d.Digits))
+		return
+	}
+	d.format(state, verb, "decimal.Decimal")
+}
+
+// FmtScanner returns a fmt.Scanner that parses into d, so it can be used with fmt.Scan and related functions.
+// The value is read as a sequence of digits, dots and signs and parsed with `NewFromString`.
+// The verbs "%v", "%s", "%d", "%e", "%f" and "%g" are supported.
+// Decimal cannot implement fmt.Scanner itself since the Scan method is already used for sql.Scanner.
+func (d *Decimal) FmtScanner() fmt.Scanner {
+	return decimalScanner{d}
+}
+
+type decimalScanner struct {
+	d *Decimal
+}
+
+func (s decimalScanner) Scan(state fmt.ScanState, verb rune) error {
+	token, err := scanNumber(state, verb, "Decimal")
+	if err != nil {
+		return err
+	}
+	val, err := NewFromString(token)
+	if err != nil {
+		return err
+	}
+	*s.d = val
+	return nil
+}
+
+// scanNumber reads the token of a number for a fmt.Scanner after skipping leading spaces.
+func scanNumber(state fmt.ScanState, verb rune, typ string) (string, error) {
+	switch verb {
+	case 'v', 's', 'd', 'e', 'E', 'f', 'F', 'g', 'G':
+	default:
+		return "", fmt.Errorf("bad verb '%%%c' for %s", verb, typ)
+	}
+	state.SkipSpace()
+	token, err := state.Token(false, func(r rune) bool {
+		return r >= '0' && r <= '9' || r == '.' || r == '-' || r == '+'
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(token) == 0 {
+		return "", io.ErrUnexpectedEOF
+	}
+	return string(token), nil
+}
+
+// format implements all verbs except "%#v" for formatting a value of the given type.
+func (d Decimal) format(state fmt.State, verb rune, typ string) {
+	switch verb {
+	case 'v':
+		if _, ok := state.Precision(); ok {
+			d.formatNumber(state, verb)
 			return
 		}
-		buf := [64]byte{}
-		for i := range buf {
-			buf[i] = char
-		}
-		for count > 0 {
-			chunk := min(count, len(buf))
-			state.Write(buf[:chunk])
-			count -= chunk
+		// Without a precision, %v handles only padding via state.Width() and state.Flag('-').
+		// Other flags are intentially ignored since even the standard library has an inconsistent approach for those.
+		writePadded(state, d.String())
+	case 's':
+		writePadded(state, d.String())
+	case 'q':
+		if state.Flag('#') {
+			writePadded(state, "`"+d.String()+"`")
+		} else {
+			writePadded(state, `"`+d.String()+`"`)
 		}
+	case 'e', 'E', 'f', 'F', 'g', 'G', 'd':
+		d.formatNumber(state, verb)
+	default:
+		fmt.Fprintf(state, "%%!%c(%s=%s)", verb, typ, d.String())
 	}
+}
+
+// formatNumber formats the value with the semantics of the floating point verbs and "%d".
+func (d Decimal) formatNumber(state fmt.State, verb rune) {
+	precision, hasPrecision := state.Precision()
+	precision = min(precision, 1024)
+	ds := d.digits()
+	var headBuf [48]byte
+	var tailBuf [8]byte
+	head, zeros, tail, lead := headBuf[:0], 0, tailBuf[:0], 0
 
 	switch verb {
-	case 'f':
-		trail := 0
-		if precision, ok := state.Precision(); ok {
-			d = d.Round(uint8(max(0, min(precision, 19))))
-			if precision > int(d.Digits) {
-				trail = min(precision, 1024) - int(d.Digits)
-			}
+	case 'e', 'E', 'f', 'F':
+		if !hasPrecision {
+			precision = 6
+		}
+		head, zeros, tail = ds.format(head, tail, byte(verb), precision)
+	case 'g', 'G', 'v':
+		if !hasPrecision {
+			precision = -1
+		}
+		head, zeros, tail = ds.format(head, tail, byte(verb), precision)
+	case 'd':
+		if state.Flag('#') {
+			ds.round(ds.dp)
 		} else {
-			d = d.Round(6)
+			ds.truncate(ds.dp)
 		}
-		width, fixedWidth := state.Width()
-		width = min(width, 1024)
-		sign := ""
-		if d.Negative {
-			sign = "-"
-		} else if state.Flag('+') {
-			sign = "+"
-		} else if state.Flag(' ') {
-			sign = " "
+		head, _ = ds.fmtF(head, 0)
+		if hasPrecision {
+			if precision == 0 && ds.nd == 0 {
+				head = head[:0]
+			}
+			lead = precision - len(head)
 		}
-		d.Negative = false
-		str := d.String()
-		if d.Digits == 0 && state.Flag('#') {
-			str = str + "."
+	}
+
+	if state.Flag('#') && verb != 'd' {
+		digits := -1
+		if verb == 'g' || verb == 'G' || verb == 'v' {
+			digits = 6
+			if hasPrecision {
+				digits = precision
+			}
 		}
-		length := len(sign) + len(str) + trail
-		if !fixedWidth {
-			io.WriteString(state, sign)
-			io.WriteString(state, str)
-			pad('0', trail)
-			return
+		head, zeros = sharp(head, zeros, digits)
+	}
+
+	// Negative values that round to zero keep their sign like floats, except for "%d" which behaves like integers.
+	sign := ""
+	if d.Negative && (verb == 'd' && ds.nd > 0 || verb != 'd' && (d.Integer != 0 || d.Fraction != 0)) {
+		sign = "-"
+	} else if state.Flag('+') {
+		sign = "+"
+	} else if state.Flag(' ') {
+		sign = " "
+	}
+	// Like for integers, a precision for %d disables padding with zeros.
+	zeroPad := state.Flag('0') && !(verb == 'd' && hasPrecision)
+	writeNumber(state, sign, lead, head, zeros, tail, zeroPad)
+}
+
+// sharp applies the "#" flag to a formatted number by ensuring it contains a decimal point
+// and, if digits is not negative, by extending it with zeros to the given number of significant digits.
+func sharp(head []byte, zeros, digits int) ([]byte, int) {
+	point, nonzero := false, false
+	for _, c := range head {
+		switch {
+		case c == '.':
+			point = true
+		case c != '0':
+			nonzero = true
+			fallthrough
+		case nonzero:
+			digits--
 		}
-		if state.Flag('-') {
-			io.WriteString(state, sign)
-			io.WriteString(state, str)
-			pad('0', trail)
-			pad(' ', width-length)
-			return
+	}
+	if nonzero {
+		digits -= zeros
+	}
+	if !point {
+		if len(head) == 1 && head[0] == '0' {
+			digits--
 		}
-		if state.Flag('0') {
-			io.WriteString(state, sign)
-			pad('0', width-length)
-			io.WriteString(state, str)
-			pad('0', trail)
-			return
+		head = append(head, '.')
+	}
+	return head, zeros + max(digits, 0)
+}
+
+// writePadded writes a string padded to the width of the state with spaces.
+func writePadded(state fmt.State, str string) {
+	width, fixedWidth := state.Width()
+	width = min(width, 1024)
+	left := state.Flag('-')
+	if fixedWidth && !left {
+		writeRepeated(state, ' ', width-len(str))
+	}
+	io.WriteString(state, str)
+	if fixedWidth && left {
+		writeRepeated(state, ' ', width-len(str))
+	}
+}
+
+// writeNumber writes a formatted number consisting of a sign, lead zeros, the digits in head,
+// trailing zeros and a tail such as an exponent padded to the width of the state.
+func writeNumber(state fmt.State, sign string, lead int, head []byte, zeros int, tail []byte, zeroPad bool) {
+	lead = max(lead, 0)
+	width, fixedWidth := state.Width()
+	width = min(width, 1024)
+	padding := 0
+	if fixedWidth {
+		padding = width - len(sign) - lead - len(head) - zeros - len(tail)
+	}
+	if padding > 0 && !state.Flag('-') {
+		if zeroPad {
+			lead += padding
+		} else {
+			writeRepeated(state, ' ', padding)
 		}
-		pad(' ', width-length)
-		io.WriteString(state, sign)
-		io.WriteString(state, str)
-		pad('0', trail)
-	case 'v':
-		// %v handles only padding via state.Width() and state.Flag('-') and debug formatting via state.Flag('#').
-		// Other flags are intentially ignored since even the standard library has an inconsistent approach for those.
-		width, fixedWidth := state.Width()
-		left := state.Flag('-')
+	}
+	io.WriteString(state, sign)
+	writeRepeated(state, '0', lead)
+	state.Write(head)
+	writeRepeated(state, '0', zeros)
+	state.Write(tail)
+	if padding > 0 && state.Flag('-') {
+		writeRepeated(state, ' ', padding)
+	}
+}
 
-		str := d.String()
-		if state.Flag('#') {
-			str = fmt.Sprintf("decimal.Decimal{Negative: %t, Integer: %d, Fraction: %d, Digits: %d}", d.Negative, d.Integer, d.Fraction, d.Digits)
+func writeRepeated(w io.Writer, char byte, count int) {
+	if count <= 0 {
+		return
+	}
+	buf := [64]byte{}
+	for i := range buf {
+		buf[i] = char
+	}
+	for count > 0 {
+		chunk := min(count, len(buf))
+		w.Write(buf[:chunk])
+		count -= chunk
+	}
+}
+
+// digits holds the significant digits of the magnitude of a value for formatting.
+// The value is 0.d[0]d[1]...d[nd-1] * 10^dp. Trailing zeros are not stored and zero has nd == 0.
+type digits struct {
+	d  [40]byte
+	nd int
+	dp int
+}
+
+func (d Decimal) digits() digits {
+	var ds digits
+	var arr [48]byte
+	d.Negative = false
+	pos := d.text(&arr)
+	n, point := 0, -1
+	for _, c := range arr[pos:] {
+		if c == '.' {
+			point = n
+			continue
+		}
+		ds.d[n] = c
+		n++
+	}
+	if point < 0 {
+		point = n
+	}
+	lead := 0
+	for lead < n && ds.d[lead] == '0' {
+		lead++
+	}
+	copy(ds.d[:], ds.d[lead:n])
+	ds.nd, ds.dp = n-lead, point-lead
+	ds.trim()
+	return ds
+}
+
+func (ds *digits) trim() {
+	for ds.nd > 0 && ds.d[ds.nd-1] == '0' {
+		ds.nd--
+	}
+	if ds.nd == 0 {
+		ds.dp = 0
+	}
+}
+
+// truncate reduces the digits to the first nd digits.
+func (ds *digits) truncate(nd int) {
+	if nd < 0 {
+		nd = 0
+	}
+	if nd < ds.nd {
+		ds.nd = nd
+		ds.trim()
+	}
+}
+
+// round rounds the digits to the first nd digits, rounding half away from zero.
+func (ds *digits) round(nd int) {
+	if nd < 0 || nd >= ds.nd {
+		ds.truncate(nd)
+		return
+	}
+	if ds.d[nd] < '5' {
+		ds.truncate(nd)
+		return
+	}
+	i := nd - 1
+	for i >= 0 && ds.d[i] == '9' {
+		i--
+	}
+	if i < 0 {
+		ds.d[0] = '1'
+		ds.nd = 1
+		ds.dp++
+		return
+	}
+	ds.d[i]++
+	ds.nd = i + 1
+}
+
+// fmtE appends the digits in the format of "%e" with prec fractional digits to buf and the exponent to tail.
+// Fractional digits beyond the available digits are returned as a number of zeros.
+func (ds *digits) fmtE(buf, tail []byte, prec int, verb byte) ([]byte, int, []byte) {
+	first := byte('0')
+	if ds.nd > 0 {
+		first = ds.d[0]
+	}
+	buf = append(buf, first)
+	zeros := 0
+	if prec > 0 {
+		buf = append(buf, '.')
+		m := min(ds.nd, prec+1)
+		if m > 1 {
+			buf = append(buf, ds.d[1:m]...)
 		}
+		zeros = prec - max(m-1, 0)
+	}
+	exp := ds.dp - 1
+	if ds.nd == 0 {
+		exp = 0
+	}
+	tail = append(tail, verb)
+	if exp < 0 {
+		tail = append(tail, '-')
+		exp = -exp
+	} else {
+		tail = append(tail, '+')
+	}
+	tail = append(tail, byte(exp/10)+'0', byte(exp%10)+'0')
+	return buf, zeros, tail
+}
 
-		if fixedWidth && !left {
-			pad(' ', width-len(str))
+// fmtF appends the digits in the format of "%f" with prec fractional digits to buf.
+// Fractional digits beyond the available digits are returned as a number of zeros.
+func (ds *digits) fmtF(buf []byte, prec int) ([]byte, int) {
+	if ds.dp > 0 {
+		m := min(ds.nd, ds.dp)
+		buf = append(buf, ds.d[:m]...)
+		for ; m < ds.dp; m++ {
+			buf = append(buf, '0')
 		}
-		io.WriteString(state, str)
-		if fixedWidth && left {
-			pad(' ', width-len(str))
+	} else {
+		buf = append(buf, '0')
+	}
+	zeros := 0
+	if prec > 0 {
+		buf = append(buf, '.')
+		avail := min(prec, ds.nd-ds.dp)
+		for i := range avail {
+			if j := ds.dp + i; j >= 0 {
+				buf = append(buf, ds.d[j])
+			} else {
+				buf = append(buf, '0')
+			}
 		}
-	default:
-		fmt.Fprintf(state, "%%!%c(decimal.Decimal=%s)", verb, d.String())
+		zeros = prec - max(avail, 0)
+	}
+	return buf, zeros
+}
+
+// format rounds and appends the digits in the format of strconv.FormatFloat with the given verb to buf
+// and the exponent, if any, to tail. "v" is treated like "g". A negative prec selects the exact value.
+// Fractional digits beyond the available digits are returned as a number of zeros.
+func (ds *digits) format(buf, tail []byte, verb byte, prec int) ([]byte, int, []byte) {
+	switch verb {
+	case 'e', 'E':
+		if prec < 0 {
+			prec = max(ds.nd-1, 0)
+		}
+		ds.round(prec + 1)
+		return ds.fmtE(buf, tail, prec, verb)
+	case 'f', 'F':
+		if prec < 0 {
+			prec = max(ds.nd-ds.dp, 0)
+		}
+		ds.round(ds.dp + prec)
+		buf, zeros := ds.fmtF(buf, prec)
+		return buf, zeros, tail
+	}
+	shortest := prec < 0
+	if shortest {
+		prec = ds.nd
+	} else {
+		prec = max(prec, 1)
+		ds.round(prec)
+	}
+	eprec := prec
+	if eprec > ds.nd && ds.nd >= ds.dp {
+		eprec = ds.nd
+	}
+	if shortest {
+		eprec = 6
+	}
+	if exp := ds.dp - 1; exp < -4 || exp >= eprec {
+		e := byte('e')
+		if verb == 'G' {
+			e = 'E'
+		}
+		return ds.fmtE(buf, tail, min(prec, ds.nd)-1, e)
+	}
+	if prec > ds.dp {
+		prec = ds.nd
 	}
+	buf, zeros := ds.fmtF(buf, max(prec-ds.dp, 0))
+	return buf, zeros, tail
+}
+
+// AppendText implements encoding.TextAppender.
+func (d Decimal) AppendText(b []byte) ([]byte, error) {
+	var arr [48]byte
+	pos := d.text(&arr)
+	return append(b, arr[pos:]...), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -406,6 +763,53 @@ func (f Fixed) String() string {
 	return string(arr[pos:])
 }
 
+// AppendFormat appends the fixed-point value formatted according to fmt and prec to dst and returns the extended buffer.
+// It follows the rules of `Decimal.AppendFormat`.
+func (f Fixed) AppendFormat(dst []byte, fmt byte, prec int) []byte {
+	return f.Decimal().AppendFormat(dst, fmt, prec)
+}
+
+// Format implements fmt.Formatter.
+// It supports the same verbs and flags as `Decimal.Format`, "%#v" prints the value as a Go expression.
+func (f Fixed) Format(state fmt.State, verb rune) {
+	if verb == 'v' && state.Flag('#') {
+		writePadded(state, fmt.Sprintf("decimal.Fixed(%d)", int32(f)))
+		return
+	}
+	f.Decimal().format(state, verb, "decimal.Fixed")
+}
+
+// FmtScanner returns a fmt.Scanner that parses into f, so it can be used with fmt.Scan and related functions.
+// The value is parsed with `NewFixedFromString` and the same verbs as for `Decimal.FmtScanner` are supported.
+// Fixed cannot implement fmt.Scanner itself since the Scan method is already used for sql.Scanner.
+func (f *Fixed) FmtScanner() fmt.Scanner {
+	return fixedScanner{f}
+}
+
+type fixedScanner struct {
+	f *Fixed
+}
+
+func (s fixedScanner) Scan(state fmt.ScanState, verb rune) error {
+	token, err := scanNumber(state, verb, "Fixed")
+	if err != nil {
+		return err
+	}
+	val, err := NewFixedFromString(token)
+	if err != nil {
+		return err
+	}
+	*s.f = val
+	return nil
+}
+
+// AppendText implements encoding.TextAppender.
+func (f Fixed) AppendText(b []byte) ([]byte, error) {
+	var arr [16]byte
+	pos := f.text(&arr)
+	return append(b, arr[pos:]...), nil
+}
+
 // MarshalText implements encoding.TextMarshaler.
 func (f Fixed) MarshalText() ([]byte, error) {
 	var arr [16]byte
@@ -4,6 +4,7 @@ import (
 	"encoding"
 	"encoding/xml"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/fossoreslp/decimal"
@@ -15,6 +16,10 @@ var (
 	_ encoding.TextUnmarshaler = (*decimal.Decimal)(nil)
 	_ encoding.TextMarshaler   = decimal.Fixed(0)
 	_ encoding.TextUnmarshaler = (*decimal.Fixed)(nil)
+	_ encoding.TextAppender    = decimal.Decimal{}
+	_ encoding.TextAppender    = decimal.Fixed(0)
+	_ fmt.Formatter            = decimal.Decimal{}
+	_ fmt.Formatter            = decimal.Fixed(0)
 )
 
 var benchmarkFormatSink string
@@ -381,11 +386,195 @@ func TestDecimal_FormatV(t *testing.T) {
 	}
 }
 
+func TestDecimal_FormatVerbs(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		d      decimal.Decimal
+		want   string
+	}{
+		{"e_default_precision", "%e", decimal.Decimal{Integer: 1234567, Fraction: 125, Digits: 3}, "1.234567e+06"},
+		{"e_upper", "%E", decimal.Decimal{Integer: 12, Fraction: 375, Digits: 3}, "1.237500E+01"},
+		{"e_small", "%.2e", decimal.Decimal{Fraction: 1220703125, Digits: 14}, "1.22e-05"},
+		{"e_zero", "%e", decimal.Decimal{}, "0.000000e+00"},
+		{"e_rounds_half_away_from_zero", "%.0e", decimal.Decimal{Negative: true, Fraction: 25, Digits: 2}, "-3e-01"},
+		{"e_rounding_carry", "%.2e", decimal.Decimal{Integer: 9, Fraction: 999, Digits: 3}, "1.00e+01"},
+		{"e_alternate_keeps_point", "%#.0e", decimal.Decimal{Integer: 5}, "5.e+00"},
+		{"e_zero_padding", "%+012.3e", decimal.Decimal{Integer: 12, Fraction: 5, Digits: 1}, "+001.250e+01"},
+		{"e_left_aligned", "%-12.1e|", decimal.Decimal{Negative: true, Integer: 100}, "-1.0e+02    |"},
+		{"e_precision_beyond_digits", "%.22e", decimal.Decimal{Fraction: 1, Digits: 19}, "1.0000000000000000000000e-19"},
+		{"g_shortest", "%g", decimal.Decimal{Integer: 12, Fraction: 375, Digits: 3}, "12.375"},
+		{"g_keeps_all_digits", "%g", decimal.Decimal{Integer: 1, Fraction: 1234567890123456789, Digits: 19}, "1.1234567890123456789"},
+		{"g_large_exponent", "%g", decimal.Decimal{Integer: 123456789}, "1.23456789e+08"},
+		{"g_small_exponent", "%G", decimal.Decimal{Fraction: 1, Digits: 5}, "1E-05"},
+		{"g_precision", "%.3g", decimal.Decimal{Integer: 1234567, Fraction: 125, Digits: 3}, "1.23e+06"},
+		{"g_precision_fixed", "%.3g", decimal.Decimal{Integer: 9, Fraction: 96875, Digits: 5}, "9.97"},
+		{"g_zero", "%g", decimal.Decimal{Digits: 3}, "0"},
+		{"g_alternate", "%#g", decimal.Decimal{Integer: 100}, "100.000"},
+		{"g_alternate_zero", "%#g", decimal.Decimal{}, "0.00000"},
+		{"g_width", "%10g", decimal.Decimal{Negative: true, Integer: 1, Fraction: 5, Digits: 1}, "      -1.5"},
+		{"g_zero_padding", "%010g", decimal.Decimal{Negative: true, Integer: 1, Fraction: 5, Digits: 1}, "-0000001.5"},
+		{"v_precision", "%.5v", decimal.Decimal{Negative: true, Integer: 99, Fraction: 99951171875, Digits: 11}, "-100"},
+		{"v_precision_exponent", "%.1v", decimal.Decimal{Integer: 1234567}, "1e+06"},
+		{"f_upper", "%F", decimal.Decimal{Integer: 1, Fraction: 5, Digits: 1}, "1.500000"},
+		{"f_rounds_to_zero_keeps_sign", "%.1f", decimal.Decimal{Negative: true, Fraction: 4, Digits: 2}, "-0.0"},
+		{"d_rounds_to_zero_without_sign", "%#d", decimal.Decimal{Negative: true, Fraction: 4, Digits: 1}, "0"},
+		{"d_truncates", "%d", decimal.Decimal{Negative: true, Integer: 12, Fraction: 9, Digits: 1}, "-12"},
+		{"d_alternate_rounds", "%#d", decimal.Decimal{Negative: true, Integer: 12, Fraction: 5, Digits: 1}, "-13"},
+		{"d_width", "%6d", decimal.Decimal{Integer: 1234, Fraction: 5, Digits: 1}, "  1234"},
+		{"d_left_aligned", "%-6d|", decimal.Decimal{Integer: 1234, Fraction: 5, Digits: 1}, "1234  |"},
+		{"d_zero_padding", "%+06d", decimal.Decimal{Integer: 42}, "+00042"},
+		{"d_precision", "%8.5d", decimal.Decimal{Negative: true, Integer: 42}, "  -00042"},
+		{"d_precision_disables_zero_padding", "%08.3d", decimal.Decimal{Integer: 42}, "     042"},
+		{"d_zero_precision_zero", "%.0d", decimal.Decimal{Fraction: 4, Digits: 1}, ""},
+		{"d_maxuint64", "%d", decimal.Decimal{Integer: 18446744073709551615, Fraction: 1, Digits: 1}, "18446744073709551615"},
+		{"s_plain", "%s", decimal.Decimal{Negative: true, Integer: 12, Fraction: 340, Digits: 3}, "-12.340"},
+		{"s_width", "%8s", decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}, "   12.34"},
+		{"s_left_aligned", "%-8s|", decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}, "12.34   |"},
+		{"q_quoted", "%q", decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}, `"12.34"`},
+		{"q_backquoted", "%#q", decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}, "`12.34`"},
+		{"q_width", "%9q", decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}, `  "12.34"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.d); got != tt.want {
+				t.Errorf("fmt.Sprintf(%q, %#v) = %q, want %q", tt.format, tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_FormatMatchesFloat(t *testing.T) {
+	// Values that are exact in binary and formats that do not round ties, so float64 formatting is the reference.
+	values := []string{"0", "1", "-1", "1.5", "-0.25", "12.375", "1234567.125", "0.0001220703125", "100", "123456789", "1000000000000000", "9.96875", "-99.99951171875", "-0.0001220703125"}
+	formats := []string{"%e", "%E", "%.2e", "%12.3e", "%-12.3e|", "%+e", "% e", "%012.3e", "%g", "%G", "%.3g", "%#g", "%#.3g", "%10g", "%-10g|", "%+g", "%010g", "%.10g", "%f", "%.3f", "%F", "%.5v", "%20.10e"}
+	for _, v := range values {
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			t.Fatalf("NewFromString(%q) error = %v", v, err)
+		}
+		f, _ := strconv.ParseFloat(v, 64)
+		for _, format := range formats {
+			if got, want := fmt.Sprintf(format, d), fmt.Sprintf(format, f); got != want {
+				t.Errorf("fmt.Sprintf(%q, %s) = %q, want %q", format, v, got, want)
+			}
+		}
+	}
+}
+
+func TestDecimal_FormatNegativeRoundsToZero(t *testing.T) {
+	d := decimal.Decimal{Negative: true, Fraction: 5, Digits: 4}
+	for _, format := range []string{"%.2f", "%+.2f", "% .2f", "%07.2f", "%.0f", "%.0e", "%.1g"} {
+		if got, want := fmt.Sprintf(format, d), fmt.Sprintf(format, -0.0005); got != want {
+			t.Errorf("fmt.Sprintf(%q, -0.0005) = %q, want %q", format, got, want)
+		}
+	}
+}
+
 func TestDecimal_FormatUnsupportedVerb(t *testing.T) {
 	d := decimal.Decimal{Integer: 12, Fraction: 34, Digits: 2}
-	want := "%!d(decimal.Decimal=12.34)"
-	if got := fmt.Sprintf("%d", d); got != want {
-		t.Errorf("fmt.Sprintf(\"%%d\", %#v) = %q, want %q", d, got, want)
+	want := "%!x(decimal.Decimal=12.34)"
+	if got := fmt.Sprintf("%x", d); got != want {
+		t.Errorf("fmt.Sprintf(\"%%x\", %#v) = %q, want %q", d, got, want)
+	}
+}
+
+func TestDecimal_AppendFormat(t *testing.T) {
+	tests := []struct {
+		d    decimal.Decimal
+		fmt  byte
+		prec int
+		want string
+	}{
+		{decimal.Decimal{Integer: 12, Fraction: 3400, Digits: 4}, 'f', -1, "12.34"},
+		{decimal.Decimal{Integer: 12, Fraction: 3400, Digits: 4}, 'f', 6, "12.340000"},
+		{decimal.Decimal{Negative: true, Integer: 12, Fraction: 345, Digits: 3}, 'f', 2, "-12.35"},
+		{decimal.Decimal{Negative: true, Fraction: 4, Digits: 2}, 'f', 1, "-0.0"},
+		{decimal.Decimal{Integer: 1, Fraction: 1234567890123456789, Digits: 19}, 'f', -1, "1.1234567890123456789"},
+		{decimal.Decimal{Integer: 1, Fraction: 2, Digits: 1}, 'F', 25, "1.2000000000000000000000000"},
+		{decimal.Decimal{Integer: 1234567}, 'e', -1, "1.234567e+06"},
+		{decimal.Decimal{Integer: 1234567}, 'E', 2, "1.23E+06"},
+		{decimal.Decimal{}, 'e', -1, "0e+00"},
+		{decimal.Decimal{Fraction: 1, Digits: 19}, 'g', -1, "1e-19"},
+		{decimal.Decimal{Integer: 123456789}, 'G', 4, "1.235E+08"},
+		{decimal.Decimal{Integer: 100, Digits: 2}, 'g', -1, "100"},
+		{decimal.Decimal{Integer: 12}, 'x', -1, "%x"},
+	}
+	for _, tt := range tests {
+		if got := string(tt.d.AppendFormat([]byte("x="), tt.fmt, tt.prec)); got != "x="+tt.want {
+			t.Errorf("AppendFormat(%v, %c, %d) = %q, want %q", tt.d, tt.fmt, tt.prec, got, "x="+tt.want)
+		}
+	}
+
+	// Values that are exact in binary without ties at the requested precision, so strconv is the reference.
+	for _, v := range []string{"0", "-1.5", "12.375", "1234567.375", "0.0001220703125", "123456789"} {
+		d, _ := decimal.NewFromString(v)
+		f, _ := strconv.ParseFloat(v, 64)
+		for _, format := range []byte("eEfgG") {
+			for _, prec := range []int{-1, 0, 2, 7} {
+				if got, want := d.AppendFormat(nil, format, prec), strconv.AppendFloat(nil, f, format, prec, 64); string(got) != string(want) {
+					t.Errorf("AppendFormat(%s, %c, %d) = %s, want %s", v, format, prec, got, want)
+				}
+			}
+		}
+	}
+
+	d := decimal.Decimal{Negative: true, Integer: 12, Fraction: 345, Digits: 3}
+	buf := make([]byte, 0, 64)
+	if allocs := testing.AllocsPerRun(100, func() { buf = d.AppendFormat(buf[:0], 'g', -1) }); allocs != 0 {
+		t.Errorf("AppendFormat() allocates %v times", allocs)
+	}
+}
+
+func TestDecimal_AppendText(t *testing.T) {
+	d := decimal.Decimal{Negative: true, Integer: 12, Fraction: 340, Digits: 3}
+	got, err := d.AppendText([]byte("v="))
+	if err != nil || string(got) != "v=-12.340" {
+		t.Errorf("AppendText() = %q, %v, want %q", got, err, "v=-12.340")
+	}
+	buf := make([]byte, 0, 48)
+	if allocs := testing.AllocsPerRun(100, func() { buf, _ = d.AppendText(buf[:0]) }); allocs != 0 {
+		t.Errorf("AppendText() allocates %v times", allocs)
+	}
+}
+
+func TestDecimal_FmtScanner(t *testing.T) {
+	var a, b decimal.Decimal
+	var name string
+	n, err := fmt.Sscan("price 12.345\n-0.5", &name, a.FmtScanner(), b.FmtScanner())
+	if err != nil || n != 3 {
+		t.Fatalf("fmt.Sscan() = %d, %v", n, err)
+	}
+	if want := (decimal.Decimal{Integer: 12, Fraction: 345, Digits: 3}); a != want {
+		t.Errorf("fmt.Sscan() a = %#v, want %#v", a, want)
+	}
+	if want := (decimal.Decimal{Negative: true, Fraction: 5, Digits: 1}); b != want {
+		t.Errorf("fmt.Sscan() b = %#v, want %#v", b, want)
+	}
+
+	var c decimal.Decimal
+	if _, err := fmt.Sscanf("total: 7.25;", "total: %f;", c.FmtScanner()); err != nil || c != (decimal.Decimal{Integer: 7, Fraction: 25, Digits: 2}) {
+		t.Errorf("fmt.Sscanf() = %#v, %v", c, err)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		input  string
+		format string
+	}{
+		{"invalid_number", "1.2.3", "%v"},
+		{"plus_sign", "+1", "%v"},
+		{"no_number", "abc", "%v"},
+		{"empty", "", "%v"},
+		{"bad_verb", "12", "%x"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var d decimal.Decimal
+			if _, err := fmt.Sscanf(tt.input, tt.format, d.FmtScanner()); err == nil {
+				t.Errorf("fmt.Sscanf(%q, %q) = %#v, want error", tt.input, tt.format, d)
+			}
+		})
 	}
 }
 
@@ -415,6 +604,21 @@ func BenchmarkDecimal_Format(b *testing.B) {
 			format: "%#.0f",
 			d:      decimal.Decimal{Integer: 12},
 		},
+		{
+			name:   "e_default_precision",
+			format: "%e",
+			d:      decimal.Decimal{Integer: 12, Fraction: 3456789, Digits: 7},
+		},
+		{
+			name:   "g_shortest",
+			format: "%g",
+			d:      decimal.Decimal{Integer: 12, Fraction: 3456789, Digits: 7},
+		},
+		{
+			name:   "d_width",
+			format: "%8d",
+			d:      decimal.Decimal{Negative: true, Integer: 12, Fraction: 34, Digits: 2},
+		},
 		{
 			name:   "v_plain",
 			format: "%v",
@@ -686,6 +890,69 @@ func BenchmarkFixed_String(b *testing.B) {
 	}
 }
 
+func TestFixed_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		f      decimal.Fixed
+		want   string
+	}{
+		{"v_plain", "%v", -1250, "-12.50"},
+		{"v_width", "%8v", 1250, "   12.50"},
+		{"v_debug", "%#v", -1250, "decimal.Fixed(-1250)"},
+		{"s_plain", "%s", 5, "0.05"},
+		{"q_quoted", "%q", 1250, `"12.50"`},
+		{"f_width_precision", "%8.2f", 1250, "   12.50"},
+		{"f_rounds", "%.1f", -1255, "-12.6"},
+		{"f_zero_padding", "%+09.3f", 1250, "+0012.500"},
+		{"e_default", "%e", 2147483647, "2.147484e+07"},
+		{"g_shortest", "%g", 1250, "12.5"},
+		{"d_truncates", "%d", -2147483648, "-21474836"},
+		{"d_alternate_rounds", "%#d", 1250, "13"},
+		{"unsupported_verb", "%x", 1250, "%!x(decimal.Fixed=12.50)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.f); got != tt.want {
+				t.Errorf("fmt.Sprintf(%q, %d) = %q, want %q", tt.format, int32(tt.f), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixed_Append(t *testing.T) {
+	f := decimal.Fixed(-12345)
+	if got, err := f.AppendText([]byte("v=")); err != nil || string(got) != "v=-123.45" {
+		t.Errorf("AppendText() = %q, %v, want %q", got, err, "v=-123.45")
+	}
+	if got := f.AppendFormat([]byte("v="), 'e', 3); string(got) != "v=-1.235e+02" {
+		t.Errorf("AppendFormat() = %q, want %q", got, "v=-1.235e+02")
+	}
+	buf := make([]byte, 0, 16)
+	if allocs := testing.AllocsPerRun(100, func() { buf, _ = f.AppendText(buf[:0]) }); allocs != 0 {
+		t.Errorf("AppendText() allocates %v times", allocs)
+	}
+}
+
+func TestFixed_FmtScanner(t *testing.T) {
+	var a, b decimal.Fixed
+	n, err := fmt.Sscan("12.5 -21474836.48", a.FmtScanner(), b.FmtScanner())
+	if err != nil || n != 2 {
+		t.Fatalf("fmt.Sscan() = %d, %v", n, err)
+	}
+	if a != 1250 || b != -2147483648 {
+		t.Errorf("fmt.Sscan() = %d, %d, want 1250, -2147483648", int32(a), int32(b))
+	}
+
+	for _, input := range []string{"1.234", "21474836.48", "x"} {
+		var f decimal.Fixed
+		if _, err := fmt.Sscan(input, f.FmtScanner()); err == nil {
+			t.Errorf("fmt.Sscan(%q) = %d, want error", input, int32(f))
+		}
+	}
+}
+
 func TestFixed_MarshalText(t *testing.T) {
 	tests := []struct {
 		name string